@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/meteor/config"
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/recipe"
+	"github.com/raystack/meteor/registry"
+	"github.com/raystack/meteor/runner"
+	"github.com/raystack/salt/cli/printer"
+	log "github.com/raystack/salt/observability/logger"
+	"github.com/spf13/cobra"
+)
+
+// errSnapshotChanged is returned by diff with --exit-code when differences are found.
+var errSnapshotChanged = errors.New("snapshot has changes")
+
+// DiffCmd creates a command object for comparing a recipe run against a snapshot.
+func DiffCmd() *cobra.Command {
+	var (
+		pathToConfig string
+		configFile   string
+		logLevel     string
+		snapshotPath string
+		format       string
+		exitCode     bool
+		filters      recipeFilters
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff <path> --against <snapshot.ndjson>",
+		Short: "Compare a recipe's output with a previous snapshot",
+		Long: heredoc.Doc(`
+			Compare what a recipe would produce with a previous snapshot.
+
+			The recipe is executed in dry-run mode, so sinks are skipped. The
+			extracted records are compared with a snapshot written by the file
+			sink in ndjson format. Entities are matched by URN and edges by
+			source, target and type.`),
+		Example: heredoc.Doc(`
+			$ meteor diff recipe.yml --against snapshot.ndjson
+
+			# output as JSON for CI
+			$ meteor diff recipe.yml --against snapshot.ndjson --format json
+
+			# fail when anything changed
+			$ meteor diff recipe.yml --against snapshot.ndjson --exit-code
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(configFile)
+			if err != nil {
+				return err
+			}
+
+			if logLevel != "" {
+				cfg.LogLevel = logLevel
+			}
+
+			lg := log.NewLogrus(log.LogrusWithLevel(cfg.LogLevel))
+			plugins.SetLog(lg)
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			before, err := readSnapshot(snapshotPath)
+			if err != nil {
+				return err
+			}
+
+			var (
+				mu    sync.Mutex
+				after []models.Record
+			)
+			rnr := runner.NewRunner(runner.Config{
+				ExtractorFactory:     registry.Extractors,
+				ProcessorFactory:     registry.Processors,
+				SinkFactory:          registry.Sinks,
+				Logger:               lg,
+				MaxRetries:           cfg.MaxRetries,
+				RetryInitialInterval: time.Duration(cfg.RetryInitialIntervalSeconds) * time.Second,
+				DryRun:               true,
+				DryRunCollector: func(_ string, records []models.Record) {
+					mu.Lock()
+					after = append(after, records...)
+					mu.Unlock()
+				},
+			})
+
+			readerOpts, err := filters.options()
+			if err != nil {
+				return err
+			}
+			reader := recipe.NewReader(lg, pathToConfig, readerOpts...)
+			recipes, err := reader.Read(args[0])
+			if err != nil {
+				return err
+			}
+			printSkippedRecipes(os.Stderr, reader.Skipped())
+			if len(recipes) == 0 {
+				return fmt.Errorf("no recipe found in [%s]", args[0])
+			}
+
+			for _, run := range rnr.RunMultiple(ctx, recipes) {
+				if run.Error != nil {
+					cmd.SilenceUsage = true
+					return fmt.Errorf("recipe %q: %w", run.Recipe.Name, run.Error)
+				}
+			}
+
+			diff, err := models.DiffSnapshots(before, after)
+			if err != nil {
+				return err
+			}
+
+			if format == "json" {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(diff); err != nil {
+					return err
+				}
+			} else {
+				printSnapshotDiff(diff)
+			}
+
+			if exitCode && !diff.IsEmpty() {
+				cmd.SilenceUsage = true
+				return errSnapshotChanged
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&snapshotPath, "against", "", "Path to an ndjson snapshot written by the file sink")
+	cmd.Flags().StringVar(&pathToConfig, "var", "", "Path to Config file with env variables for recipe")
+	cmd.Flags().StringVarP(&configFile, "config", "c", "./meteor.yaml", "file path for agent level config")
+	cmd.Flags().StringVar(&logLevel, "log-level", "", "Override log level (debug, info, warn, error)")
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format (text, json)")
+	cmd.Flags().BoolVar(&exitCode, "exit-code", false, "Exit with a non-zero status when differences are found")
+	filters.addFlags(cmd)
+
+	if err := cmd.MarkFlagRequired("against"); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// readSnapshot reads records from an ndjson file written by the file sink.
+func readSnapshot(path string) ([]models.Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	var records []models.Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		rec, err := models.RecordFromJSON(scanner.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("read snapshot %s line %d: %w", path, line, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read snapshot %s: %w", path, err)
+	}
+
+	return records, nil
+}
+
+// printSnapshotDiff prints a human readable summary of the diff.
+func printSnapshotDiff(diff models.SnapshotDiff) {
+	fmt.Printf("Entities: %d added, %d removed, %d changed\n",
+		len(diff.AddedEntities), len(diff.RemovedEntities), len(diff.ChangedEntities))
+	fmt.Printf("Edges: %d added, %d removed\n", len(diff.AddedEdges), len(diff.RemovedEdges))
+
+	if diff.IsEmpty() {
+		fmt.Println(printer.Green("\nNo changes"))
+		return
+	}
+
+	fmt.Println()
+	for _, urn := range diff.AddedEntities {
+		fmt.Println(printer.Greenf("+ %s", urn))
+	}
+	for _, urn := range diff.RemovedEntities {
+		fmt.Println(printer.Redf("- %s", urn))
+	}
+	for _, ec := range diff.ChangedEntities {
+		fmt.Println(printer.Yellowf("~ %s", ec.URN))
+		for _, c := range ec.Changes {
+			fmt.Printf("    %s\n", c)
+		}
+	}
+	for _, e := range diff.AddedEdges {
+		fmt.Println(printer.Greenf("+ %s %s -> %s", e.Type, e.SourceURN, e.TargetURN))
+	}
+	for _, e := range diff.RemovedEdges {
+		fmt.Println(printer.Redf("- %s %s -> %s", e.Type, e.SourceURN, e.TargetURN))
+	}
+}
@@ -24,6 +24,7 @@ func LintCmd() *cobra.Command {
 		success  = 0
 		failures = 0
 		logLevel string
+		filters  recipeFilters
 	)
 
 	cmd := &cobra.Command{
@@ -44,6 +45,9 @@ func LintCmd() *cobra.Command {
 
 			# lint all recipes in the current directory
 			$ meteor lint .
+
+			# lint the recipes labelled team=payments
+			$ meteor lint recipes/ --selector team=payments
 		`),
 		Annotations: map[string]string{
 			"group": "core",
@@ -68,12 +72,18 @@ func LintCmd() *cobra.Command {
 				Logger:           lg,
 			})
 
-			recipes, err := recipe.NewReader(lg, "").Read(args[0])
+			readerOpts, err := filters.options()
+			if err != nil {
+				return err
+			}
+			reader := recipe.NewReader(lg, "", readerOpts...)
+			recipes, err := reader.Read(args[0])
 			if err != nil {
 				return err
 			}
+			skipped := reader.Skipped()
 
-			if len(recipes) == 0 {
+			if len(recipes) == 0 && len(skipped) == 0 {
 				fmt.Println(printer.Yellowf("No recipe found in [%s]", args[0]))
 				fmt.Println(printer.Blue("\nUse 'meteor gen recipe' to generate a new recipe."))
 				return nil
@@ -98,13 +108,20 @@ func LintCmd() *cobra.Command {
 				report = append(report, row)
 			}
 
+			// Files that could not be read are failures too
+			for _, s := range skipped {
+				fmt.Printf("%s: %s\n", s.Path, s.Err)
+				report = append(report, []string{fmt.Sprintf("%s  %s", printer.Icon("failure"), s.Path), printer.Greyf("(not a valid recipe)")})
+				failures++
+			}
+
 			// Print the report
 			if failures > 0 {
 				fmt.Println("\nSome checks were not successful")
 			} else {
 				fmt.Println("\nAll checks were successful")
 			}
-			fmt.Printf("%d failing, %d successful, and %d total\n\n", failures, success, len(recipes))
+			fmt.Printf("%d failing, %d successful, and %d total\n\n", failures, success, len(recipes)+len(skipped))
 			printer.Table(os.Stdout, report)
 
 			return nil
@@ -112,6 +129,7 @@ func LintCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&logLevel, "log-level", "", "Override log level (debug, info, warn, error)")
+	filters.addFlags(cmd)
 
 	return cmd
 }
@@ -124,23 +142,29 @@ func printLintErrors(errs []error, rcp recipe.Recipe) {
 	)
 
 	for _, err := range errs {
+		src := rcp.Source
+		var srcErr runner.SourceError
+		if errors.As(err, &srcErr) {
+			src = rcp.Sources[srcErr.Index]
+		}
 		if errors.As(err, &notFoundErr) {
-			printPluginErrors(rcp, notFoundErr)
+			printPluginErrors(rcp, src, notFoundErr)
 			continue
 		}
 		if errors.As(err, &invalidCfgErr) {
-			printConfigErrors(rcp, invalidCfgErr)
+			printConfigErrors(rcp, src, invalidCfgErr)
 			continue
 		}
 		fmt.Printf("%s: recipe error: %s\n", rcp.Name, err.Error())
 	}
 }
 
-// printPluginErrors print the plugin's type error
-func printPluginErrors(rcp recipe.Recipe, err plugins.NotFoundError) {
+// printPluginErrors print the plugin's type error, src is the source
+// the error belongs to if it is an extractor error
+func printPluginErrors(rcp recipe.Recipe, src recipe.PluginRecipe, err plugins.NotFoundError) {
 	switch err.Type {
 	case plugins.PluginTypeExtractor:
-		printPluginError(rcp, rcp.Source, err)
+		printPluginError(rcp, src, err)
 
 	case plugins.PluginTypeProcessor:
 		plugin, exists := findPluginByName(rcp.Processors, err.Name)
@@ -169,10 +193,10 @@ func printPluginError(rcp recipe.Recipe, plugin recipe.PluginRecipe, err plugins
 }
 
 // printConfigErrors print the plugin's config error
-func printConfigErrors(rcp recipe.Recipe, err plugins.InvalidConfigError) {
+func printConfigErrors(rcp recipe.Recipe, src recipe.PluginRecipe, err plugins.InvalidConfigError) {
 	switch err.Type {
 	case plugins.PluginTypeExtractor:
-		printConfigError(rcp, rcp.Source.Node, err)
+		printConfigError(rcp, src.Node, err)
 
 	case plugins.PluginTypeProcessor:
 		plugin, exists := findPluginByName(rcp.Processors, err.PluginName)
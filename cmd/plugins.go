@@ -10,6 +10,7 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/scaffold"
 	"github.com/raystack/meteor/registry"
 	"github.com/raystack/salt/cli/printer"
 	"github.com/spf13/cobra"
@@ -26,6 +27,7 @@ func PluginsCmd() *cobra.Command {
 	}
 	cmd.AddCommand(pluginsListCmd())
 	cmd.AddCommand(pluginsInfoCmd())
+	cmd.AddCommand(pluginsNewCmd())
 	return cmd
 }
 
@@ -188,6 +190,74 @@ func pluginsInfoCmd() *cobra.Command {
 	return cmd
 }
 
+func pluginsNewCmd() *cobra.Command {
+	var (
+		entities []string
+		edges    []string
+		dir      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "new <type> <name>",
+		Short: "Scaffold a new plugin",
+		Long: heredoc.Doc(`
+			Generate the skeleton of a new built-in plugin.
+
+			The command writes the plugin package with its Config struct and
+			plugins.Info, a README with a sample config and table-driven test
+			stubs, and registers the package in populate.go. Run it from the
+			root of the meteor repository or point --dir at it.
+
+			Extractors need at least one entity type. Edges are given as
+			type:from:to.
+		`),
+		Example: heredoc.Doc(`
+			$ meteor plugins new extractor acme --entities table,dashboard
+			$ meteor plugins new extractor acme --entities table,user --edges owned_by:table:user
+			$ meteor plugins new processor tidy
+			$ meteor plugins new sink acme
+		`),
+		Args: cobra.ExactArgs(2),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := scaffold.Params{
+				Type:     plugins.PluginType(args[0]),
+				Name:     args[1],
+				Entities: entities,
+			}
+			for _, e := range edges {
+				edge, err := scaffold.ParseEdge(e)
+				if err != nil {
+					return err
+				}
+				params.Edges = append(params.Edges, edge)
+			}
+
+			cmd.SilenceUsage = true
+			files, err := scaffold.Generate(params, dir)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(printer.Greenf("Created %s %q", params.Type, params.Name))
+			for _, f := range files {
+				fmt.Printf("  %s\n", f)
+			}
+			fmt.Printf("\nNext, fill in the TODOs, add the plugin to docs/reference/%ss.mdx and run:\n\n", params.Type)
+			fmt.Println("  go test -tags plugins ./plugins/" + string(params.Type) + "s/" + params.Name + "/...")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&entities, "entities", nil, "Entity types emitted by an extractor, comma separated")
+	cmd.Flags().StringSliceVar(&edges, "edges", nil, "Edge types emitted by an extractor as type:from:to, comma separated")
+	cmd.Flags().StringVar(&dir, "dir", ".", "Root of the meteor repository")
+
+	return cmd
+}
+
 func resolvePluginName(args []string) (string, error) {
 	if len(args) == 1 {
 		return args[0], nil
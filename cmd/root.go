@@ -1,13 +1,22 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+
 	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/meteor/plugins/external"
+	"github.com/raystack/meteor/registry"
 	"github.com/raystack/salt/cli/commander"
+	"github.com/raystack/salt/cli/printer"
 	"github.com/spf13/cobra"
 )
 
 // New adds all child commands to the root command and sets flags appropriately.
 func New() *cobra.Command {
+	var pluginsDir string
+
 	var cmd = &cobra.Command{
 		Use:           "meteor <command> <subcommand> [flags]",
 		Short:         "Metadata CLI",
@@ -17,6 +26,7 @@ func New() *cobra.Command {
 		Example: heredoc.Doc(`
 			$ meteor run recipe.yaml
 			$ meteor lint recipe.yaml
+			$ meteor diff recipe.yaml --against snapshot.ndjson
 			$ meteor plugins list
 			$ meteor plugins info bigquery
 			$ meteor recipe init sample -n mycompany -e bigquery -s compass
@@ -31,12 +41,21 @@ func New() *cobra.Command {
 				Open an issue here https://github.com/raystack/meteor/issues
 			`),
 		},
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			// A broken plugin must not stop the built-in ones from working.
+			if err := external.Register(pluginsDir, registry.Extractors, registry.Processors, registry.Sinks); err != nil {
+				fmt.Fprintln(os.Stderr, printer.Yellowf("WARN: loading external plugins: %s", err))
+			}
+		},
 	}
 
+	cmd.PersistentFlags().StringVar(&pluginsDir, "plugins-dir", defaultPluginsDir(), "Directory with external plugin executables (env METEOR_PLUGINS_DIR)")
+
 	commander.New(cmd).Init()
 
 	cmd.AddCommand(RunCmd())
 	cmd.AddCommand(LintCmd())
+	cmd.AddCommand(DiffCmd())
 	cmd.AddCommand(RecipeCmd())
 	cmd.AddCommand(PluginsCmd())
 	cmd.AddCommand(EntitiesCmd())
@@ -45,3 +64,15 @@ func New() *cobra.Command {
 
 	return cmd
 }
+
+// defaultPluginsDir returns $METEOR_PLUGINS_DIR, falling back to ~/.meteor/plugins.
+func defaultPluginsDir() string {
+	if dir := os.Getenv("METEOR_PLUGINS_DIR"); dir != "" {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".meteor", "plugins")
+}
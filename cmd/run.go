@@ -15,7 +15,9 @@ import (
 	"github.com/raystack/meteor/runner"
 	"github.com/raystack/meteor/config"
 	"github.com/raystack/meteor/metrics"
+	"github.com/raystack/meteor/metrics/otelhttpclient"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/httprecorder"
 	"github.com/raystack/meteor/recipe"
 	"github.com/raystack/meteor/registry"
 	"github.com/raystack/salt/cli/printer"
@@ -35,6 +37,10 @@ func RunCmd() *cobra.Command {
 		logLevel     string
 		dryRun       bool
 		recordLimit  int
+		traceURN     string
+		recordHTTP   string
+		replayHTTP   string
+		filters      recipeFilters
 	)
 
 	cmd := &cobra.Command{
@@ -47,7 +53,9 @@ func RunCmd() *cobra.Command {
 			and in Meteor they are used to define how metadata will be collected.
 
 			If a recipe file is provided, recipe will be executed as a single recipe.
-			If a recipe directory is provided, recipes will be executed as a group of recipes.`),
+			If a recipe directory is provided, recipes will be executed as a group of recipes.
+			YAML files are discovered recursively; narrow them down with --include,
+			--exclude and a --selector on recipe labels.`),
 		Example: heredoc.Doc(`
 			$ meteor run recipe.yml
 
@@ -57,11 +65,21 @@ func RunCmd() *cobra.Command {
 			# run all recipes in the current directory
 			$ meteor run .
 
+			# run the recipes of one team, ignoring drafts
+			$ meteor run recipes/ --selector team=payments --exclude '**/draft-*'
+
 			# dry-run to preview extracted records without sending to sinks
 			$ meteor run recipe.yml --dry-run
 
 			# extract only the first 10 records for testing
 			$ meteor run recipe.yml --dry-run --limit 10
+
+			# show how matching records change through processors and sinks
+			$ meteor run recipe.yml --dry-run --trace-urn "urn:bigquery:*:table:*orders*"
+
+			# record HTTP traffic of HTTP-based plugins for a bug report, then replay it
+			$ meteor run recipe.yml --record-http ./cassette
+			$ meteor run recipe.yml --replay-http ./cassette
 		`),
 		Args: cobra.ExactArgs(1),
 		Annotations: map[string]string{
@@ -96,6 +114,16 @@ func RunCmd() *cobra.Command {
 				mts = metrics.NewOtelMonitor()
 			}
 
+			stopHTTPRecorder, err := setupHTTPRecorder(recordHTTP, replayHTTP)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := stopHTTPRecorder(); err != nil {
+					lg.Error("save http cassette", "error", err)
+				}
+			}()
+
 			rnr := runner.NewRunner(runner.Config{
 				ExtractorFactory:     registry.Extractors,
 				ProcessorFactory:     registry.Processors,
@@ -108,12 +136,20 @@ func RunCmd() *cobra.Command {
 				SinkBatchSize:        cfg.SinkBatchSize,
 				DryRun:               dryRun,
 				RecordLimit:          recordLimit,
+				TraceURN:             traceURN,
+				TraceOutput:          os.Stdout,
 			})
 
-			recipes, err := recipe.NewReader(lg, pathToConfig).Read(args[0])
+			readerOpts, err := filters.options()
+			if err != nil {
+				return err
+			}
+			reader := recipe.NewReader(lg, pathToConfig, readerOpts...)
+			recipes, err := reader.Read(args[0])
 			if err != nil {
 				return err
 			}
+			printSkippedRecipes(os.Stdout, reader.Skipped())
 
 			if len(recipes) == 0 {
 				fmt.Println(printer.Icon("warning"), printer.Yellowf("No recipe found in [%s]", args[0]))
@@ -145,10 +181,10 @@ func RunCmd() *cobra.Command {
 				if run.Error != nil {
 					lg.Error(run.Error.Error(), "recipe", run.Recipe.Name)
 					failures++
-					row = append(row, printer.Icon("failure"), run.Recipe.Name, printer.Grey(run.Recipe.Source.Name), printer.Greyf("%v ms", strconv.Itoa(run.DurationInMs)), printer.Greyf("%s", strconv.Itoa(run.RecordCount)), printer.Greyf("%s", entitySummary))
+					row = append(row, printer.Icon("failure"), run.Recipe.Name, printer.Grey(formatSources(run.Recipe)), printer.Greyf("%v ms", strconv.Itoa(run.DurationInMs)), printer.Greyf("%s", strconv.Itoa(run.RecordCount)), printer.Greyf("%s", entitySummary))
 				} else {
 					success++
-					row = append(row, printer.Icon("success"), run.Recipe.Name, printer.Grey(run.Recipe.Source.Name), printer.Greyf("%v ms", strconv.Itoa(run.DurationInMs)), printer.Greyf("%s", strconv.Itoa(run.RecordCount)), printer.Greyf("%s", entitySummary))
+					row = append(row, printer.Icon("success"), run.Recipe.Name, printer.Grey(formatSources(run.Recipe)), printer.Greyf("%v ms", strconv.Itoa(run.DurationInMs)), printer.Greyf("%s", strconv.Itoa(run.RecordCount)), printer.Greyf("%s", entitySummary))
 				}
 				report = append(report, row)
 				if err = bar.Add(1); err != nil {
@@ -173,14 +209,48 @@ func RunCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&pathToConfig, "var", "", "Path to Config file with env variables for recipe")
+	filters.addFlags(cmd)
 	cmd.Flags().StringVarP(&configFile, "config", "c", "./meteor.yaml", "file path for agent level config")
 	cmd.Flags().StringVar(&logLevel, "log-level", "", "Override log level (debug, info, warn, error)")
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Extract records without sending to sinks")
 	cmd.Flags().IntVar(&recordLimit, "limit", 0, "Maximum number of records to extract (0 = unlimited)")
+	cmd.Flags().StringVar(&traceURN, "trace-urn", "", "Print the journey of records whose URN matches this glob")
+	cmd.Flags().StringVar(&recordHTTP, "record-http", "", "Record HTTP traffic of HTTP-based plugins into a cassette in this directory")
+	cmd.Flags().StringVar(&replayHTTP, "replay-http", "", "Replay HTTP traffic of HTTP-based plugins from a cassette in this directory")
+	cmd.MarkFlagsMutuallyExclusive("record-http", "replay-http")
 
 	return cmd
 }
 
+// setupHTTPRecorder wraps the transports of HTTP-based plugins to record
+// or replay their traffic. The returned function saves the cassette.
+func setupHTTPRecorder(recordDir, replayDir string) (stop func() error, err error) {
+	mode, dir := httprecorder.ModeRecord, recordDir
+	if replayDir != "" {
+		mode, dir = httprecorder.ModeReplay, replayDir
+	}
+	if dir == "" {
+		return func() error { return nil }, nil
+	}
+
+	rec, err := httprecorder.New(dir, mode)
+	if err != nil {
+		return nil, err
+	}
+	otelhttpclient.SetTransportWrapper(rec.Wrap)
+
+	return func() error {
+		otelhttpclient.SetTransportWrapper(nil)
+		if err := rec.Stop(); err != nil {
+			return err
+		}
+		if mode == httprecorder.ModeRecord {
+			fmt.Printf("HTTP traffic recorded to %s\n", rec.Path())
+		}
+		return nil
+	}, nil
+}
+
 // formatEntityTypes returns a compact summary of entity types.
 func formatEntityTypes(types map[string]int) string {
 	if len(types) == 0 {
@@ -200,6 +270,15 @@ func formatEntityTypes(types map[string]int) string {
 	return strings.Join(parts, ", ")
 }
 
+// formatSources returns the names of the sources of a recipe.
+func formatSources(rcp recipe.Recipe) string {
+	var names []string
+	for _, src := range rcp.AllSources() {
+		names = append(names, src.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
 // printRunErrors prints error details for failed runs.
 func printRunErrors(runs []runner.Run) {
 	for _, run := range runs {
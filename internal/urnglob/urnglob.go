@@ -0,0 +1,29 @@
+// Package urnglob matches URNs against the glob patterns used across
+// recipes, such as the urns of sink and processor selectors.
+package urnglob
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Compile turns a URN glob into an anchored regular expression.
+// '*' matches any sequence of characters (including ':' and '/') and
+// '?' matches a single character.
+func Compile(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,26 @@
+package urnglob_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/internal/urnglob"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		pattern string
+		urn     string
+		match   bool
+	}{
+		{pattern: "urn:bigquery:prod:table:*", urn: "urn:bigquery:prod:table:shop.orders", match: true},
+		{pattern: "urn:bigquery:*:table:shop.orders", urn: "urn:bigquery:prod:table:shop.orders", match: true},
+		{pattern: "urn:bigquery:prod?:table:*", urn: "urn:bigquery:prod1:table:shop.orders", match: true},
+		{pattern: "urn:bigquery:prod?:table:*", urn: "urn:bigquery:prod:table:shop.orders", match: false},
+		{pattern: "urn:bigquery:prod:table:shop.orders", urn: "urn:bigquery:prod:table:shopXorders", match: false},
+		{pattern: "urn:bigquery:prod:*", urn: "urn:postgres:prod:table:app.users", match: false},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.match, urnglob.Compile(tc.pattern).MatchString(tc.urn), "%s %s", tc.pattern, tc.urn)
+	}
+}
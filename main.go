@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/raystack/meteor/cmd"
+	"github.com/raystack/meteor/plugins/external"
 
 	_ "github.com/raystack/meteor/plugins/extractors"
 	_ "github.com/raystack/meteor/plugins/processors"
@@ -23,6 +24,7 @@ func main() {
 	root := cmd.New()
 
 	cmd, err := root.ExecuteC()
+	external.Cleanup()
 
 	if err == nil {
 		return
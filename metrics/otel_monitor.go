@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"strings"
 
 	"github.com/raystack/meteor/runner"
 	"go.opentelemetry.io/otel"
@@ -46,27 +47,40 @@ func (m *OtelMonitor) RecordRun(ctx context.Context, run runner.Run) {
 		float64(run.DurationInMs)/1000.0,
 		metric.WithAttributes(
 			attribute.String("recipe_name", run.Recipe.Name),
-			attribute.String("extractor", run.Recipe.Source.Name),
+			attribute.String("extractor", strings.Join(getSliceStringPluginNames(run.Recipe.AllSources()), ",")),
 			attribute.StringSlice("processors", getSliceStringPluginNames(run.Recipe.Processors)),
 			attribute.StringSlice("sinks", getSliceStringPluginNames(run.Recipe.Sinks)),
 			attribute.Bool("success", run.Success),
 		))
 
-	m.extractorRetries.Add(ctx,
-		int64(run.ExtractorRetries),
-		metric.WithAttributes(
-			attribute.String("recipe_name", run.Recipe.Name),
-			attribute.String("extractor", run.Recipe.Source.Name),
-		))
+	sources := run.Sources
+	if len(sources) == 0 {
+		sources = []runner.SourceRun{{
+			Name:        run.Recipe.Source.Name,
+			Scope:       run.Recipe.Source.Scope,
+			RecordCount: run.RecordsExtracted,
+			Retries:     run.ExtractorRetries,
+		}}
+	}
+	for _, src := range sources {
+		m.extractorRetries.Add(ctx,
+			int64(src.Retries),
+			metric.WithAttributes(
+				attribute.String("recipe_name", run.Recipe.Name),
+				attribute.String("extractor", src.Name),
+				attribute.String("scope", src.Scope),
+			))
 
-	m.recordsExtracted.Add(ctx,
-		int64(run.RecordsExtracted),
-		metric.WithAttributes(
-			attribute.String("recipe_name", run.Recipe.Name),
-			attribute.String("extractor", run.Recipe.Source.Name),
-			attribute.StringSlice("processors", getSliceStringPluginNames(run.Recipe.Processors)),
-			attribute.StringSlice("sinks", getSliceStringPluginNames(run.Recipe.Sinks)),
-		))
+		m.recordsExtracted.Add(ctx,
+			int64(src.RecordCount),
+			metric.WithAttributes(
+				attribute.String("recipe_name", run.Recipe.Name),
+				attribute.String("extractor", src.Name),
+				attribute.String("scope", src.Scope),
+				attribute.StringSlice("processors", getSliceStringPluginNames(run.Recipe.Processors)),
+				attribute.StringSlice("sinks", getSliceStringPluginNames(run.Recipe.Sinks)),
+			))
+	}
 }
 
 // RecordPlugin records a individual plugin behavior in a run, this is being handled in otelmw
@@ -28,6 +28,16 @@ const (
 	attributeResponseStatusCode = "http.response.status_code"
 )
 
+// transportWrapper, when set, wraps the base transport of every client
+// built with NewHTTPTransport, e.g. to record or replay HTTP traffic.
+var transportWrapper func(http.RoundTripper) http.RoundTripper
+
+// SetTransportWrapper registers fn to wrap the base transport of every
+// subsequently created transport. Passing nil removes the wrapper.
+func SetTransportWrapper(fn func(http.RoundTripper) http.RoundTripper) {
+	transportWrapper = fn
+}
+
 type httpTransport struct {
 	roundTripper http.RoundTripper
 
@@ -45,6 +55,10 @@ func NewHTTPTransport(baseTransport http.RoundTripper) http.RoundTripper {
 		baseTransport = http.DefaultTransport
 	}
 
+	if transportWrapper != nil {
+		baseTransport = transportWrapper(baseTransport)
+	}
+
 	icl := &httpTransport{roundTripper: baseTransport}
 	icl.createMeasures(otel.Meter("github.com/raystack/meteor/metrics/otehttpclient"))
 
@@ -1,6 +1,8 @@
 package otelhttpclient_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/raystack/meteor/metrics/otelhttpclient"
@@ -11,3 +13,33 @@ func TestNewHTTPTransport(t *testing.T) {
 	tr := otelhttpclient.NewHTTPTransport(nil)
 	assert.NotNil(t, tr)
 }
+
+func TestSetTransportWrapper(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var wrapped int
+	otelhttpclient.SetTransportWrapper(func(base http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			wrapped++
+			return base.RoundTrip(req)
+		})
+	})
+	defer otelhttpclient.SetTransportWrapper(nil)
+
+	cl := &http.Client{Transport: otelhttpclient.NewHTTPTransport(nil)}
+	resp, err := cl.Get(srv.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, wrapped)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
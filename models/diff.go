@@ -0,0 +1,259 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Change describes a single field-level difference between two records.
+// Path uses dotted notation for maps and [i] for list elements,
+// e.g. "entity.properties.columns[0].data_type".
+type Change struct {
+	Path string `json:"path"`
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
+
+// Kind returns "added", "removed" or "changed".
+func (c Change) Kind() string {
+	switch {
+	case c.Old == nil:
+		return "added"
+	case c.New == nil:
+		return "removed"
+	default:
+		return "changed"
+	}
+}
+
+func (c Change) String() string {
+	switch c.Kind() {
+	case "added":
+		return fmt.Sprintf("+ %s: %s", c.Path, formatDiffValue(c.New))
+	case "removed":
+		return fmt.Sprintf("- %s: %s", c.Path, formatDiffValue(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, formatDiffValue(c.Old), formatDiffValue(c.New))
+	}
+}
+
+// DiffRecords returns the changes needed to turn record a into record b,
+// sorted by path. Both records are compared in their JSON form.
+func DiffRecords(a, b Record) ([]Change, error) {
+	am, err := recordToMap(a)
+	if err != nil {
+		return nil, err
+	}
+	bm, err := recordToMap(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffMaps(am, bm), nil
+}
+
+// DiffMaps returns the changes needed to turn map a into map b, sorted by path.
+func DiffMaps(a, b map[string]any) []Change {
+	af, bf := flatten("", a), flatten("", b)
+
+	var changes []Change
+	for path, av := range af {
+		bv, ok := bf[path]
+		if !ok {
+			changes = append(changes, Change{Path: path, Old: av})
+			continue
+		}
+		if !reflect.DeepEqual(av, bv) {
+			changes = append(changes, Change{Path: path, Old: av, New: bv})
+		}
+	}
+	for path, bv := range bf {
+		if _, ok := af[path]; !ok {
+			changes = append(changes, Change{Path: path, New: bv})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func recordToMap(r Record) (map[string]any, error) {
+	if r.Entity() == nil {
+		return map[string]any{}, nil
+	}
+
+	b, err := RecordToJSON(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal record: %w", err)
+	}
+	return m, nil
+}
+
+// flatten collapses nested maps and lists into leaf values keyed by path.
+// Empty maps and lists are kept as leaves so that they show up in a diff.
+func flatten(prefix string, v any) map[string]any {
+	out := make(map[string]any)
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 && prefix != "" {
+			out[prefix] = val
+		}
+		for k, child := range val {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			for p, leaf := range flatten(key, child) {
+				out[p] = leaf
+			}
+		}
+	case []any:
+		if len(val) == 0 {
+			out[prefix] = val
+		}
+		for i, child := range val {
+			for p, leaf := range flatten(fmt.Sprintf("%s[%d]", prefix, i), child) {
+				out[p] = leaf
+			}
+		}
+	default:
+		out[prefix] = val
+	}
+	return out
+}
+
+func formatDiffValue(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+// EdgeRef identifies an edge by its endpoints and type.
+type EdgeRef struct {
+	SourceURN string `json:"source_urn"`
+	TargetURN string `json:"target_urn"`
+	Type      string `json:"type"`
+}
+
+// EntityChange lists the property changes of an entity present in both snapshots.
+type EntityChange struct {
+	URN     string   `json:"urn"`
+	Changes []Change `json:"changes"`
+}
+
+// SnapshotDiff is the difference between two sets of records.
+type SnapshotDiff struct {
+	AddedEntities   []string       `json:"added_entities"`
+	RemovedEntities []string       `json:"removed_entities"`
+	ChangedEntities []EntityChange `json:"changed_entities"`
+	AddedEdges      []EdgeRef      `json:"added_edges"`
+	RemovedEdges    []EdgeRef      `json:"removed_edges"`
+}
+
+// IsEmpty reports whether the snapshots are equivalent.
+func (d SnapshotDiff) IsEmpty() bool {
+	return len(d.AddedEntities) == 0 && len(d.RemovedEntities) == 0 &&
+		len(d.ChangedEntities) == 0 && len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0
+}
+
+// DiffSnapshots compares the records in before and after. Entities are
+// matched by URN and edges by source, target and type. When a URN appears
+// more than once in a snapshot, the last record wins.
+func DiffSnapshots(before, after []Record) (SnapshotDiff, error) {
+	beforeEntities, beforeEdges, err := indexSnapshot(before)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+	afterEntities, afterEdges, err := indexSnapshot(after)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	diff := SnapshotDiff{
+		AddedEntities:   []string{},
+		RemovedEntities: []string{},
+		ChangedEntities: []EntityChange{},
+		AddedEdges:      []EdgeRef{},
+		RemovedEdges:    []EdgeRef{},
+	}
+	for _, urn := range sortedKeys(afterEntities) {
+		old, ok := beforeEntities[urn]
+		if !ok {
+			diff.AddedEntities = append(diff.AddedEntities, urn)
+			continue
+		}
+		if changes := DiffMaps(old, afterEntities[urn]); len(changes) > 0 {
+			diff.ChangedEntities = append(diff.ChangedEntities, EntityChange{URN: urn, Changes: changes})
+		}
+	}
+	for _, urn := range sortedKeys(beforeEntities) {
+		if _, ok := afterEntities[urn]; !ok {
+			diff.RemovedEntities = append(diff.RemovedEntities, urn)
+		}
+	}
+
+	for ref := range afterEdges {
+		if _, ok := beforeEdges[ref]; !ok {
+			diff.AddedEdges = append(diff.AddedEdges, ref)
+		}
+	}
+	for ref := range beforeEdges {
+		if _, ok := afterEdges[ref]; !ok {
+			diff.RemovedEdges = append(diff.RemovedEdges, ref)
+		}
+	}
+	sortEdgeRefs(diff.AddedEdges)
+	sortEdgeRefs(diff.RemovedEdges)
+
+	return diff, nil
+}
+
+func indexSnapshot(records []Record) (map[string]map[string]any, map[EdgeRef]struct{}, error) {
+	entities := make(map[string]map[string]any, len(records))
+	edges := make(map[EdgeRef]struct{})
+	for _, r := range records {
+		if r.Entity() == nil {
+			continue
+		}
+
+		b, err := EntityToJSON(r.Entity())
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal entity (%s): %w", r.Entity().GetUrn(), err)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal entity (%s): %w", r.Entity().GetUrn(), err)
+		}
+		// update_time moves on every extraction and would drown out real changes
+		delete(m, "update_time")
+		entities[r.Entity().GetUrn()] = m
+
+		for _, e := range r.Edges() {
+			edges[EdgeRef{SourceURN: e.GetSourceUrn(), TargetURN: e.GetTargetUrn(), Type: e.GetType()}] = struct{}{}
+		}
+	}
+	return entities, edges, nil
+}
+
+func sortEdgeRefs(refs []EdgeRef) {
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].SourceURN != refs[j].SourceURN {
+			return refs[i].SourceURN < refs[j].SourceURN
+		}
+		if refs[i].Type != refs[j].Type {
+			return refs[i].Type < refs[j].Type
+		}
+		return refs[i].TargetURN < refs[j].TargetURN
+	})
+}
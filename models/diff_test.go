@@ -0,0 +1,99 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffRecords(t *testing.T) {
+	t.Run("should return no changes for equal records", func(t *testing.T) {
+		a := models.NewRecord(models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{"a": "b"}))
+		b := models.NewRecord(models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{"a": "b"}))
+
+		changes, err := models.DiffRecords(a, b)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("should report added, removed and changed paths", func(t *testing.T) {
+		a := models.NewRecord(
+			models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{
+				"owner":   "alice",
+				"columns": []any{map[string]any{"name": "id", "data_type": "int"}},
+			}),
+			models.OwnerEdge("urn:test:s:table:t1", "urn:user:alice", "test"),
+		)
+		b := models.NewRecord(
+			models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{
+				"columns": []any{map[string]any{"name": "id", "data_type": "bigint"}},
+				"labels":  map[string]any{"team": "data"},
+			}),
+		)
+
+		changes, err := models.DiffRecords(a, b)
+		require.NoError(t, err)
+		assert.Equal(t, []models.Change{
+			{Path: "edges[0].source", Old: "test"},
+			{Path: "edges[0].source_urn", Old: "urn:test:s:table:t1"},
+			{Path: "edges[0].target_urn", Old: "urn:user:alice"},
+			{Path: "edges[0].type", Old: "owned_by"},
+			{Path: "entity.properties.columns[0].data_type", Old: "int", New: "bigint"},
+			{Path: "entity.properties.labels.team", New: "data"},
+			{Path: "entity.properties.owner", Old: "alice"},
+		}, changes)
+		assert.Equal(t, `~ entity.properties.columns[0].data_type: "int" -> "bigint"`, changes[4].String())
+		assert.Equal(t, "added", changes[5].Kind())
+		assert.Equal(t, "removed", changes[6].Kind())
+	})
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	t.Run("should return empty diff for identical snapshots", func(t *testing.T) {
+		records := []models.Record{
+			models.NewRecord(
+				models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{"a": "b"}),
+				models.DerivedFromEdge("urn:test:s:table:t1", "urn:test:s:table:t0", "test"),
+			),
+		}
+
+		diff, err := models.DiffSnapshots(records, records)
+		require.NoError(t, err)
+		assert.True(t, diff.IsEmpty())
+	})
+
+	t.Run("should report added and removed entities, changed properties and edges", func(t *testing.T) {
+		before := []models.Record{
+			models.NewRecord(
+				models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{"owner": "alice"}),
+				models.DerivedFromEdge("urn:test:s:table:t1", "urn:test:s:table:t0", "test"),
+			),
+			models.NewRecord(models.NewEntity("urn:test:s:table:gone", "table", "gone", "test", nil)),
+		}
+		after := []models.Record{
+			models.NewRecord(
+				models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{"owner": "bob"}),
+				models.DerivedFromEdge("urn:test:s:table:t1", "urn:test:s:table:t2", "test"),
+			),
+			models.NewRecord(models.NewEntity("urn:test:s:table:new", "table", "new", "test", nil)),
+		}
+
+		diff, err := models.DiffSnapshots(before, after)
+		require.NoError(t, err)
+		assert.False(t, diff.IsEmpty())
+		assert.Equal(t, []string{"urn:test:s:table:new"}, diff.AddedEntities)
+		assert.Equal(t, []string{"urn:test:s:table:gone"}, diff.RemovedEntities)
+		assert.Equal(t, []models.EntityChange{{
+			URN:     "urn:test:s:table:t1",
+			Changes: []models.Change{{Path: "properties.owner", Old: "alice", New: "bob"}},
+		}}, diff.ChangedEntities)
+		assert.Equal(t, []models.EdgeRef{{
+			SourceURN: "urn:test:s:table:t1", TargetURN: "urn:test:s:table:t2", Type: "derived_from",
+		}}, diff.AddedEdges)
+		assert.Equal(t, []models.EdgeRef{{
+			SourceURN: "urn:test:s:table:t1", TargetURN: "urn:test:s:table:t0", Type: "derived_from",
+		}}, diff.RemovedEdges)
+	})
+}
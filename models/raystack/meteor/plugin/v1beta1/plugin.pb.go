@@ -0,0 +1,1138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: raystack/meteor/plugin/v1beta1/plugin.proto
+
+package pluginv1beta1
+
+import (
+	v1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Record is an entity together with its edges.
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entity        *v1beta1.Entity        `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Edges         []*v1beta1.Edge        `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetEntity() *v1beta1.Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *Record) GetEdges() []*v1beta1.Edge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type EntityInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	UrnPattern    string                 `protobuf:"bytes,2,opt,name=urn_pattern,json=urnPattern,proto3" json:"urn_pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityInfo) Reset() {
+	*x = EntityInfo{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityInfo) ProtoMessage() {}
+
+func (x *EntityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityInfo.ProtoReflect.Descriptor instead.
+func (*EntityInfo) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EntityInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EntityInfo) GetUrnPattern() string {
+	if x != nil {
+		return x.UrnPattern
+	}
+	return ""
+}
+
+type EdgeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EdgeInfo) Reset() {
+	*x = EdgeInfo{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeInfo) ProtoMessage() {}
+
+func (x *EdgeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeInfo.ProtoReflect.Descriptor instead.
+func (*EdgeInfo) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EdgeInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EdgeInfo) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EdgeInfo) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type PluginInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	SampleConfig  string                 `protobuf:"bytes,2,opt,name=sample_config,json=sampleConfig,proto3" json:"sample_config,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Entities      []*EntityInfo          `protobuf:"bytes,5,rep,name=entities,proto3" json:"entities,omitempty"`
+	Edges         []*EdgeInfo            `protobuf:"bytes,6,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginInfo) Reset() {
+	*x = PluginInfo{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginInfo) ProtoMessage() {}
+
+func (x *PluginInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginInfo.ProtoReflect.Descriptor instead.
+func (*PluginInfo) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PluginInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetSampleConfig() string {
+	if x != nil {
+		return x.SampleConfig
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PluginInfo) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetEntities() []*EntityInfo {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *PluginInfo) GetEdges() []*EdgeInfo {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type PluginConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UrnScope      string                 `protobuf:"bytes,1,opt,name=urn_scope,json=urnScope,proto3" json:"urn_scope,omitempty"`
+	RawConfig     *structpb.Struct       `protobuf:"bytes,2,opt,name=raw_config,json=rawConfig,proto3" json:"raw_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginConfig) Reset() {
+	*x = PluginConfig{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginConfig) ProtoMessage() {}
+
+func (x *PluginConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginConfig.ProtoReflect.Descriptor instead.
+func (*PluginConfig) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PluginConfig) GetUrnScope() string {
+	if x != nil {
+		return x.UrnScope
+	}
+	return ""
+}
+
+func (x *PluginConfig) GetRawConfig() *structpb.Struct {
+	if x != nil {
+		return x.RawConfig
+	}
+	return nil
+}
+
+// ConfigError describes an invalid configuration key.
+type ConfigError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigError) Reset() {
+	*x = ConfigError{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigError) ProtoMessage() {}
+
+func (x *ConfigError) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigError.ProtoReflect.Descriptor instead.
+func (*ConfigError) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfigError) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type InfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InfoRequest) Reset() {
+	*x = InfoRequest{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfoRequest) ProtoMessage() {}
+
+func (x *InfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfoRequest.ProtoReflect.Descriptor instead.
+func (*InfoRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+type InfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *PluginInfo            `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InfoResponse) Reset() {
+	*x = InfoResponse{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfoResponse) ProtoMessage() {}
+
+func (x *InfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfoResponse.ProtoReflect.Descriptor instead.
+func (*InfoResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InfoResponse) GetInfo() *PluginInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *PluginConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidateRequest) GetConfig() *PluginConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// ValidateResponse lists configuration errors, if any. Other failures are
+// returned as gRPC status errors.
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Errors        []*ConfigError         `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateResponse) GetErrors() []*ConfigError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type InitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *PluginConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitRequest) Reset() {
+	*x = InitRequest{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitRequest) ProtoMessage() {}
+
+func (x *InitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InitRequest) GetConfig() *PluginConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type InitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Errors        []*ConfigError         `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitResponse) Reset() {
+	*x = InitResponse{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitResponse) ProtoMessage() {}
+
+func (x *InitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
+func (*InitResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InitResponse) GetErrors() []*ConfigError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExtractRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtractRequest) Reset() {
+	*x = ExtractRequest{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractRequest) ProtoMessage() {}
+
+func (x *ExtractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractRequest.ProtoReflect.Descriptor instead.
+func (*ExtractRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{12}
+}
+
+type ExtractResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *Record                `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtractResponse) Reset() {
+	*x = ExtractResponse{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractResponse) ProtoMessage() {}
+
+func (x *ExtractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractResponse.ProtoReflect.Descriptor instead.
+func (*ExtractResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExtractResponse) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ProcessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *Record                `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessRequest) Reset() {
+	*x = ProcessRequest{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessRequest) ProtoMessage() {}
+
+func (x *ProcessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessRequest.ProtoReflect.Descriptor instead.
+func (*ProcessRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProcessRequest) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ProcessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *Record                `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResponse) Reset() {
+	*x = ProcessResponse{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResponse) ProtoMessage() {}
+
+func (x *ProcessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResponse.ProtoReflect.Descriptor instead.
+func (*ProcessResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProcessResponse) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type SinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*Record              `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SinkRequest) Reset() {
+	*x = SinkRequest{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinkRequest) ProtoMessage() {}
+
+func (x *SinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SinkRequest.ProtoReflect.Descriptor instead.
+func (*SinkRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SinkRequest) GetRecords() []*Record {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type SinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SinkResponse) Reset() {
+	*x = SinkResponse{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinkResponse) ProtoMessage() {}
+
+func (x *SinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SinkResponse.ProtoReflect.Descriptor instead.
+func (*SinkResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{17}
+}
+
+type CloseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseRequest) Reset() {
+	*x = CloseRequest{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRequest) ProtoMessage() {}
+
+func (x *CloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRequest.ProtoReflect.Descriptor instead.
+func (*CloseRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{18}
+}
+
+type CloseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseResponse) Reset() {
+	*x = CloseResponse{}
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseResponse) ProtoMessage() {}
+
+func (x *CloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseResponse.ProtoReflect.Descriptor instead.
+func (*CloseResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP(), []int{19}
+}
+
+var File_raystack_meteor_plugin_v1beta1_plugin_proto protoreflect.FileDescriptor
+
+const file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDesc = "" +
+	"\n" +
+	"+raystack/meteor/plugin/v1beta1/plugin.proto\x12\x1eraystack.meteor.plugin.v1beta1\x1a\x1cgoogle/protobuf/struct.proto\x1a$raystack/meteor/v1beta1/record.proto\"v\n" +
+	"\x06Record\x127\n" +
+	"\x06entity\x18\x01 \x01(\v2\x1f.raystack.meteor.v1beta1.EntityR\x06entity\x123\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1d.raystack.meteor.v1beta1.EdgeR\x05edges\"A\n" +
+	"\n" +
+	"EntityInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\vurn_pattern\x18\x02 \x01(\tR\n" +
+	"urnPattern\"B\n" +
+	"\bEdgeInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x89\x02\n" +
+	"\n" +
+	"PluginInfo\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12#\n" +
+	"\rsample_config\x18\x02 \x01(\tR\fsampleConfig\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12F\n" +
+	"\bentities\x18\x05 \x03(\v2*.raystack.meteor.plugin.v1beta1.EntityInfoR\bentities\x12>\n" +
+	"\x05edges\x18\x06 \x03(\v2(.raystack.meteor.plugin.v1beta1.EdgeInfoR\x05edges\"c\n" +
+	"\fPluginConfig\x12\x1b\n" +
+	"\turn_scope\x18\x01 \x01(\tR\burnScope\x126\n" +
+	"\n" +
+	"raw_config\x18\x02 \x01(\v2\x17.google.protobuf.StructR\trawConfig\"9\n" +
+	"\vConfigError\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\r\n" +
+	"\vInfoRequest\"N\n" +
+	"\fInfoResponse\x12>\n" +
+	"\x04info\x18\x01 \x01(\v2*.raystack.meteor.plugin.v1beta1.PluginInfoR\x04info\"W\n" +
+	"\x0fValidateRequest\x12D\n" +
+	"\x06config\x18\x01 \x01(\v2,.raystack.meteor.plugin.v1beta1.PluginConfigR\x06config\"W\n" +
+	"\x10ValidateResponse\x12C\n" +
+	"\x06errors\x18\x01 \x03(\v2+.raystack.meteor.plugin.v1beta1.ConfigErrorR\x06errors\"S\n" +
+	"\vInitRequest\x12D\n" +
+	"\x06config\x18\x01 \x01(\v2,.raystack.meteor.plugin.v1beta1.PluginConfigR\x06config\"S\n" +
+	"\fInitResponse\x12C\n" +
+	"\x06errors\x18\x01 \x03(\v2+.raystack.meteor.plugin.v1beta1.ConfigErrorR\x06errors\"\x10\n" +
+	"\x0eExtractRequest\"Q\n" +
+	"\x0fExtractResponse\x12>\n" +
+	"\x06record\x18\x01 \x01(\v2&.raystack.meteor.plugin.v1beta1.RecordR\x06record\"P\n" +
+	"\x0eProcessRequest\x12>\n" +
+	"\x06record\x18\x01 \x01(\v2&.raystack.meteor.plugin.v1beta1.RecordR\x06record\"Q\n" +
+	"\x0fProcessResponse\x12>\n" +
+	"\x06record\x18\x01 \x01(\v2&.raystack.meteor.plugin.v1beta1.RecordR\x06record\"O\n" +
+	"\vSinkRequest\x12@\n" +
+	"\arecords\x18\x01 \x03(\v2&.raystack.meteor.plugin.v1beta1.RecordR\arecords\"\x0e\n" +
+	"\fSinkResponse\"\x0e\n" +
+	"\fCloseRequest\"\x0f\n" +
+	"\rCloseResponse2\xb5\x03\n" +
+	"\x10ExtractorService\x12a\n" +
+	"\x04Info\x12+.raystack.meteor.plugin.v1beta1.InfoRequest\x1a,.raystack.meteor.plugin.v1beta1.InfoResponse\x12m\n" +
+	"\bValidate\x12/.raystack.meteor.plugin.v1beta1.ValidateRequest\x1a0.raystack.meteor.plugin.v1beta1.ValidateResponse\x12a\n" +
+	"\x04Init\x12+.raystack.meteor.plugin.v1beta1.InitRequest\x1a,.raystack.meteor.plugin.v1beta1.InitResponse\x12l\n" +
+	"\aExtract\x12..raystack.meteor.plugin.v1beta1.ExtractRequest\x1a/.raystack.meteor.plugin.v1beta1.ExtractResponse0\x012\xb3\x03\n" +
+	"\x10ProcessorService\x12a\n" +
+	"\x04Info\x12+.raystack.meteor.plugin.v1beta1.InfoRequest\x1a,.raystack.meteor.plugin.v1beta1.InfoResponse\x12m\n" +
+	"\bValidate\x12/.raystack.meteor.plugin.v1beta1.ValidateRequest\x1a0.raystack.meteor.plugin.v1beta1.ValidateResponse\x12a\n" +
+	"\x04Init\x12+.raystack.meteor.plugin.v1beta1.InitRequest\x1a,.raystack.meteor.plugin.v1beta1.InitResponse\x12j\n" +
+	"\aProcess\x12..raystack.meteor.plugin.v1beta1.ProcessRequest\x1a/.raystack.meteor.plugin.v1beta1.ProcessResponse2\x8b\x04\n" +
+	"\vSinkService\x12a\n" +
+	"\x04Info\x12+.raystack.meteor.plugin.v1beta1.InfoRequest\x1a,.raystack.meteor.plugin.v1beta1.InfoResponse\x12m\n" +
+	"\bValidate\x12/.raystack.meteor.plugin.v1beta1.ValidateRequest\x1a0.raystack.meteor.plugin.v1beta1.ValidateResponse\x12a\n" +
+	"\x04Init\x12+.raystack.meteor.plugin.v1beta1.InitRequest\x1a,.raystack.meteor.plugin.v1beta1.InitResponse\x12a\n" +
+	"\x04Sink\x12+.raystack.meteor.plugin.v1beta1.SinkRequest\x1a,.raystack.meteor.plugin.v1beta1.SinkResponse\x12d\n" +
+	"\x05Close\x12,.raystack.meteor.plugin.v1beta1.CloseRequest\x1a-.raystack.meteor.plugin.v1beta1.CloseResponseBPZNgithub.com/raystack/meteor/models/raystack/meteor/plugin/v1beta1;pluginv1beta1b\x06proto3"
+
+var (
+	file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescOnce sync.Once
+	file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescData []byte
+)
+
+func file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescGZIP() []byte {
+	file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescOnce.Do(func() {
+		file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDesc), len(file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDesc)))
+	})
+	return file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDescData
+}
+
+var file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_raystack_meteor_plugin_v1beta1_plugin_proto_goTypes = []any{
+	(*Record)(nil),           // 0: raystack.meteor.plugin.v1beta1.Record
+	(*EntityInfo)(nil),       // 1: raystack.meteor.plugin.v1beta1.EntityInfo
+	(*EdgeInfo)(nil),         // 2: raystack.meteor.plugin.v1beta1.EdgeInfo
+	(*PluginInfo)(nil),       // 3: raystack.meteor.plugin.v1beta1.PluginInfo
+	(*PluginConfig)(nil),     // 4: raystack.meteor.plugin.v1beta1.PluginConfig
+	(*ConfigError)(nil),      // 5: raystack.meteor.plugin.v1beta1.ConfigError
+	(*InfoRequest)(nil),      // 6: raystack.meteor.plugin.v1beta1.InfoRequest
+	(*InfoResponse)(nil),     // 7: raystack.meteor.plugin.v1beta1.InfoResponse
+	(*ValidateRequest)(nil),  // 8: raystack.meteor.plugin.v1beta1.ValidateRequest
+	(*ValidateResponse)(nil), // 9: raystack.meteor.plugin.v1beta1.ValidateResponse
+	(*InitRequest)(nil),      // 10: raystack.meteor.plugin.v1beta1.InitRequest
+	(*InitResponse)(nil),     // 11: raystack.meteor.plugin.v1beta1.InitResponse
+	(*ExtractRequest)(nil),   // 12: raystack.meteor.plugin.v1beta1.ExtractRequest
+	(*ExtractResponse)(nil),  // 13: raystack.meteor.plugin.v1beta1.ExtractResponse
+	(*ProcessRequest)(nil),   // 14: raystack.meteor.plugin.v1beta1.ProcessRequest
+	(*ProcessResponse)(nil),  // 15: raystack.meteor.plugin.v1beta1.ProcessResponse
+	(*SinkRequest)(nil),      // 16: raystack.meteor.plugin.v1beta1.SinkRequest
+	(*SinkResponse)(nil),     // 17: raystack.meteor.plugin.v1beta1.SinkResponse
+	(*CloseRequest)(nil),     // 18: raystack.meteor.plugin.v1beta1.CloseRequest
+	(*CloseResponse)(nil),    // 19: raystack.meteor.plugin.v1beta1.CloseResponse
+	(*v1beta1.Entity)(nil),   // 20: raystack.meteor.v1beta1.Entity
+	(*v1beta1.Edge)(nil),     // 21: raystack.meteor.v1beta1.Edge
+	(*structpb.Struct)(nil),  // 22: google.protobuf.Struct
+}
+var file_raystack_meteor_plugin_v1beta1_plugin_proto_depIdxs = []int32{
+	20, // 0: raystack.meteor.plugin.v1beta1.Record.entity:type_name -> raystack.meteor.v1beta1.Entity
+	21, // 1: raystack.meteor.plugin.v1beta1.Record.edges:type_name -> raystack.meteor.v1beta1.Edge
+	1,  // 2: raystack.meteor.plugin.v1beta1.PluginInfo.entities:type_name -> raystack.meteor.plugin.v1beta1.EntityInfo
+	2,  // 3: raystack.meteor.plugin.v1beta1.PluginInfo.edges:type_name -> raystack.meteor.plugin.v1beta1.EdgeInfo
+	22, // 4: raystack.meteor.plugin.v1beta1.PluginConfig.raw_config:type_name -> google.protobuf.Struct
+	3,  // 5: raystack.meteor.plugin.v1beta1.InfoResponse.info:type_name -> raystack.meteor.plugin.v1beta1.PluginInfo
+	4,  // 6: raystack.meteor.plugin.v1beta1.ValidateRequest.config:type_name -> raystack.meteor.plugin.v1beta1.PluginConfig
+	5,  // 7: raystack.meteor.plugin.v1beta1.ValidateResponse.errors:type_name -> raystack.meteor.plugin.v1beta1.ConfigError
+	4,  // 8: raystack.meteor.plugin.v1beta1.InitRequest.config:type_name -> raystack.meteor.plugin.v1beta1.PluginConfig
+	5,  // 9: raystack.meteor.plugin.v1beta1.InitResponse.errors:type_name -> raystack.meteor.plugin.v1beta1.ConfigError
+	0,  // 10: raystack.meteor.plugin.v1beta1.ExtractResponse.record:type_name -> raystack.meteor.plugin.v1beta1.Record
+	0,  // 11: raystack.meteor.plugin.v1beta1.ProcessRequest.record:type_name -> raystack.meteor.plugin.v1beta1.Record
+	0,  // 12: raystack.meteor.plugin.v1beta1.ProcessResponse.record:type_name -> raystack.meteor.plugin.v1beta1.Record
+	0,  // 13: raystack.meteor.plugin.v1beta1.SinkRequest.records:type_name -> raystack.meteor.plugin.v1beta1.Record
+	6,  // 14: raystack.meteor.plugin.v1beta1.ExtractorService.Info:input_type -> raystack.meteor.plugin.v1beta1.InfoRequest
+	8,  // 15: raystack.meteor.plugin.v1beta1.ExtractorService.Validate:input_type -> raystack.meteor.plugin.v1beta1.ValidateRequest
+	10, // 16: raystack.meteor.plugin.v1beta1.ExtractorService.Init:input_type -> raystack.meteor.plugin.v1beta1.InitRequest
+	12, // 17: raystack.meteor.plugin.v1beta1.ExtractorService.Extract:input_type -> raystack.meteor.plugin.v1beta1.ExtractRequest
+	6,  // 18: raystack.meteor.plugin.v1beta1.ProcessorService.Info:input_type -> raystack.meteor.plugin.v1beta1.InfoRequest
+	8,  // 19: raystack.meteor.plugin.v1beta1.ProcessorService.Validate:input_type -> raystack.meteor.plugin.v1beta1.ValidateRequest
+	10, // 20: raystack.meteor.plugin.v1beta1.ProcessorService.Init:input_type -> raystack.meteor.plugin.v1beta1.InitRequest
+	14, // 21: raystack.meteor.plugin.v1beta1.ProcessorService.Process:input_type -> raystack.meteor.plugin.v1beta1.ProcessRequest
+	6,  // 22: raystack.meteor.plugin.v1beta1.SinkService.Info:input_type -> raystack.meteor.plugin.v1beta1.InfoRequest
+	8,  // 23: raystack.meteor.plugin.v1beta1.SinkService.Validate:input_type -> raystack.meteor.plugin.v1beta1.ValidateRequest
+	10, // 24: raystack.meteor.plugin.v1beta1.SinkService.Init:input_type -> raystack.meteor.plugin.v1beta1.InitRequest
+	16, // 25: raystack.meteor.plugin.v1beta1.SinkService.Sink:input_type -> raystack.meteor.plugin.v1beta1.SinkRequest
+	18, // 26: raystack.meteor.plugin.v1beta1.SinkService.Close:input_type -> raystack.meteor.plugin.v1beta1.CloseRequest
+	7,  // 27: raystack.meteor.plugin.v1beta1.ExtractorService.Info:output_type -> raystack.meteor.plugin.v1beta1.InfoResponse
+	9,  // 28: raystack.meteor.plugin.v1beta1.ExtractorService.Validate:output_type -> raystack.meteor.plugin.v1beta1.ValidateResponse
+	11, // 29: raystack.meteor.plugin.v1beta1.ExtractorService.Init:output_type -> raystack.meteor.plugin.v1beta1.InitResponse
+	13, // 30: raystack.meteor.plugin.v1beta1.ExtractorService.Extract:output_type -> raystack.meteor.plugin.v1beta1.ExtractResponse
+	7,  // 31: raystack.meteor.plugin.v1beta1.ProcessorService.Info:output_type -> raystack.meteor.plugin.v1beta1.InfoResponse
+	9,  // 32: raystack.meteor.plugin.v1beta1.ProcessorService.Validate:output_type -> raystack.meteor.plugin.v1beta1.ValidateResponse
+	11, // 33: raystack.meteor.plugin.v1beta1.ProcessorService.Init:output_type -> raystack.meteor.plugin.v1beta1.InitResponse
+	15, // 34: raystack.meteor.plugin.v1beta1.ProcessorService.Process:output_type -> raystack.meteor.plugin.v1beta1.ProcessResponse
+	7,  // 35: raystack.meteor.plugin.v1beta1.SinkService.Info:output_type -> raystack.meteor.plugin.v1beta1.InfoResponse
+	9,  // 36: raystack.meteor.plugin.v1beta1.SinkService.Validate:output_type -> raystack.meteor.plugin.v1beta1.ValidateResponse
+	11, // 37: raystack.meteor.plugin.v1beta1.SinkService.Init:output_type -> raystack.meteor.plugin.v1beta1.InitResponse
+	17, // 38: raystack.meteor.plugin.v1beta1.SinkService.Sink:output_type -> raystack.meteor.plugin.v1beta1.SinkResponse
+	19, // 39: raystack.meteor.plugin.v1beta1.SinkService.Close:output_type -> raystack.meteor.plugin.v1beta1.CloseResponse
+	27, // [27:40] is the sub-list for method output_type
+	14, // [14:27] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_raystack_meteor_plugin_v1beta1_plugin_proto_init() }
+func file_raystack_meteor_plugin_v1beta1_plugin_proto_init() {
+	if File_raystack_meteor_plugin_v1beta1_plugin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDesc), len(file_raystack_meteor_plugin_v1beta1_plugin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_raystack_meteor_plugin_v1beta1_plugin_proto_goTypes,
+		DependencyIndexes: file_raystack_meteor_plugin_v1beta1_plugin_proto_depIdxs,
+		MessageInfos:      file_raystack_meteor_plugin_v1beta1_plugin_proto_msgTypes,
+	}.Build()
+	File_raystack_meteor_plugin_v1beta1_plugin_proto = out.File
+	file_raystack_meteor_plugin_v1beta1_plugin_proto_goTypes = nil
+	file_raystack_meteor_plugin_v1beta1_plugin_proto_depIdxs = nil
+}
@@ -120,6 +120,38 @@ func RecordToJSON(r Record) ([]byte, error) {
 	return json.Marshal(result)
 }
 
+// RecordFromJSON parses a record serialized with RecordToJSON.
+func RecordFromJSON(data []byte) (Record, error) {
+	var raw struct {
+		Entity json.RawMessage   `json:"entity"`
+		Edges  []json.RawMessage `json:"edges"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Record{}, fmt.Errorf("unmarshal record: %w", err)
+	}
+	if len(raw.Entity) == 0 {
+		return Record{}, fmt.Errorf("unmarshal record: missing entity")
+	}
+
+	opts := protojson.UnmarshalOptions{DiscardUnknown: true}
+
+	var entity meteorv1beta1.Entity
+	if err := opts.Unmarshal(raw.Entity, &entity); err != nil {
+		return Record{}, fmt.Errorf("unmarshal entity: %w", err)
+	}
+
+	edges := make([]*meteorv1beta1.Edge, 0, len(raw.Edges))
+	for _, e := range raw.Edges {
+		var edge meteorv1beta1.Edge
+		if err := opts.Unmarshal(e, &edge); err != nil {
+			return Record{}, fmt.Errorf("unmarshal edge: %w", err)
+		}
+		edges = append(edges, &edge)
+	}
+
+	return NewRecord(&entity, edges...), nil
+}
+
 // RecordToMarkdown serializes a record (entity + edges) to Markdown.
 func RecordToMarkdown(r Record) ([]byte, error) {
 	var b strings.Builder
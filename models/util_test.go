@@ -8,6 +8,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestNewURN(t *testing.T) {
@@ -160,6 +161,34 @@ func TestRecordToJSONWithMultipleEdges(t *testing.T) {
 	assert.Contains(t, s, `"urn:user:bob@co.com"`)
 }
 
+func TestRecordFromJSON(t *testing.T) {
+	t.Run("should round trip a record written by RecordToJSON", func(t *testing.T) {
+		entity := models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", map[string]any{
+			"columns": []any{map[string]any{"name": "id"}},
+		})
+		record := models.NewRecord(entity, models.OwnerEdge("urn:test:s:table:t1", "urn:user:alice", "test"))
+
+		b, err := models.RecordToJSON(record)
+		require.NoError(t, err)
+
+		actual, err := models.RecordFromJSON(b)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(entity, actual.Entity()))
+		require.Len(t, actual.Edges(), 1)
+		assert.True(t, proto.Equal(record.Edges()[0], actual.Edges()[0]))
+	})
+
+	t.Run("should return error when entity is missing", func(t *testing.T) {
+		_, err := models.RecordFromJSON([]byte(`{"edges":[]}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error on invalid json", func(t *testing.T) {
+		_, err := models.RecordFromJSON([]byte(`{`))
+		assert.Error(t, err)
+	})
+}
+
 func TestRecordToMarkdown(t *testing.T) {
 	t.Run("minimal entity without properties or edges", func(t *testing.T) {
 		entity := models.NewEntity("urn:test:s:table:t1", "table", "t1", "test", nil)
@@ -35,6 +35,10 @@ func (p *BasePlugin) Validate(config Config) error {
 // Init will be called once before running the plugin.
 // This is where you want to initiate any client or test any connection to external service.
 func (p *BasePlugin) Init(ctx context.Context, config Config) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	p.UrnScope = config.URNScope
 	p.RawConfig = config.RawConfig
 
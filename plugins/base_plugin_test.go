@@ -75,6 +75,23 @@ func TestBasePluginValidate(t *testing.T) {
 		})
 	})
 
+	t.Run("should return InvalidConfigError for invalid regular expressions", func(t *testing.T) {
+		config := struct {
+			Patterns []string `mapstructure:"patterns" validate:"dive,regexp"`
+		}{}
+
+		basePlugin := plugins.NewBasePlugin(plugins.Info{}, &config)
+		err := basePlugin.Validate(plugins.Config{
+			RawConfig: map[string]any{"patterns": []any{"^ok$", "(unclosed"}},
+		})
+
+		assert.Equal(t, plugins.InvalidConfigError{
+			Errors: []plugins.ConfigError{
+				{Key: "patterns[1]", Message: "field 'patterns[1]' must be a valid regular expression, got \"(unclosed\""},
+			},
+		}, err)
+	})
+
 	t.Run("should return no error if config is valid", func(t *testing.T) {
 		validConfig := struct {
 			FieldA string `validate:"required"`
@@ -0,0 +1,177 @@
+package plugins
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config field types reported by ConfigField.Type.
+const (
+	FieldTypeString   = "string"
+	FieldTypeInt      = "int"
+	FieldTypeFloat    = "float"
+	FieldTypeBool     = "bool"
+	FieldTypeDuration = "duration"
+	FieldTypeList     = "list"
+	FieldTypeMap      = "map"
+	FieldTypeObject   = "object"
+)
+
+// secretField matches config keys that hold credentials.
+var secretField = regexp.MustCompile(`(?i)(password|passwd|secret|token|api_?key|credential|service_account|private_key|access_key|connection_url|dsn)`)
+
+// ConfigField describes a config key of a plugin, derived from the struct
+// tags of its Config and the comments of its sample config.
+type ConfigField struct {
+	// Key is the config key, dotted for fields of nested structs.
+	Key      string
+	Type     string
+	Required bool
+	Default  string
+	// Options lists the allowed values, or the allowed list elements for lists.
+	Options []string
+	// Secret is set for keys that look like credentials.
+	Secret      bool
+	Description string
+}
+
+// ConfigDescriber is implemented by plugins that can describe their config.
+// Every plugin built on BasePlugin does.
+type ConfigDescriber interface {
+	ConfigFields() []ConfigField
+}
+
+// ConfigFields describes the config of the plugin.
+func (p *BasePlugin) ConfigFields() []ConfigField {
+	return ConfigFields(p.configRef, p.info.SampleConfig)
+}
+
+// ConfigFields lists the fields of the config struct pointed to by
+// configRef. Descriptions are taken from the comments above each key in
+// sampleConfig.
+func ConfigFields(configRef any, sampleConfig string) []ConfigField {
+	if configRef == nil {
+		return nil
+	}
+	t := reflect.TypeOf(configRef)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	descriptions := make(map[string]string)
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(sampleConfig), &node); err == nil {
+		collectComments("", &node, descriptions)
+	}
+
+	return structFields("", t, true, descriptions)
+}
+
+func structFields(prefix string, t reflect.Type, parentRequired bool, descriptions map[string]string) []ConfigField {
+	var fields []ConfigField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(sf.Tag.Get("mapstructure"), ",")
+		if name == "-" {
+			continue
+		}
+		if strings.Contains(opts, "squash") {
+			fields = append(fields, structFields(prefix, sf.Type, parentRequired, descriptions)...)
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(sf.Name)
+		}
+		key := prefix + name
+
+		rules := strings.Split(sf.Tag.Get("validate"), ",")
+		field := ConfigField{
+			Key:         key,
+			Type:        fieldType(sf.Type),
+			Required:    parentRequired && slices.Contains(rules, "required"),
+			Default:     sf.Tag.Get("default"),
+			Secret:      secretField.MatchString(name),
+			Description: descriptions[key],
+		}
+		for _, rule := range rules {
+			if values, ok := strings.CutPrefix(rule, "oneof="); ok {
+				field.Options = strings.Fields(values)
+			}
+		}
+
+		// nested structs are flattened so that each of their keys can be set
+		if field.Type == FieldTypeObject && sf.Type.Kind() == reflect.Struct {
+			nested := structFields(key+".", sf.Type, parentRequired, descriptions)
+			if len(nested) > 0 {
+				fields = append(fields, nested...)
+				continue
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func fieldType(t reflect.Type) string {
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return FieldTypeDuration
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return FieldTypeString
+	case reflect.Bool:
+		return FieldTypeBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return FieldTypeInt
+	case reflect.Float32, reflect.Float64:
+		return FieldTypeFloat
+	case reflect.Slice, reflect.Array:
+		return FieldTypeList
+	case reflect.Map:
+		return FieldTypeMap
+	default:
+		return FieldTypeObject
+	}
+}
+
+// collectComments maps dotted keys of a yaml document to the comment above them.
+func collectComments(prefix string, node *yaml.Node, out map[string]string) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, c := range node.Content {
+			collectComments(prefix, c, out)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k, v := node.Content[i], node.Content[i+1]
+			if comment := cleanComment(k.HeadComment); comment != "" {
+				out[prefix+k.Value] = comment
+			}
+			collectComments(prefix+k.Value+".", v, out)
+		}
+	}
+}
+
+func cleanComment(c string) string {
+	var lines []string
+	for _, line := range strings.Split(c, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#"))
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, " ")
+}
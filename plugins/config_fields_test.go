@@ -0,0 +1,64 @@
+//go:build plugins
+// +build plugins
+
+package plugins_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/meteor/plugins"
+	"github.com/stretchr/testify/assert"
+)
+
+type fieldsConfig struct {
+	Host     string        `mapstructure:"host" validate:"required"`
+	Port     int           `mapstructure:"port" default:"5432"`
+	Mode     string        `mapstructure:"mode" validate:"omitempty,oneof=full incremental" default:"full"`
+	Password string        `mapstructure:"password" validate:"required"`
+	Verbose  bool          `mapstructure:"verbose"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+	Tables   []string      `mapstructure:"tables"`
+	Labels   map[string]string
+	Auth     struct {
+		Token string `mapstructure:"token" validate:"required"`
+	} `mapstructure:"auth"`
+	Shared struct {
+		Region string `mapstructure:"region" validate:"required"`
+	} `mapstructure:",squash"`
+	Internal string `mapstructure:"-"`
+}
+
+func TestConfigFields(t *testing.T) {
+	sample := heredoc.Doc(`
+		# The hostname of the database
+		host: localhost
+		auth:
+		  # Token sent with
+		  # every request
+		  token: abc
+	`)
+
+	fields := plugins.ConfigFields(&fieldsConfig{}, sample)
+
+	assert.Equal(t, []plugins.ConfigField{
+		{Key: "host", Type: plugins.FieldTypeString, Required: true, Description: "The hostname of the database"},
+		{Key: "port", Type: plugins.FieldTypeInt, Default: "5432"},
+		{Key: "mode", Type: plugins.FieldTypeString, Default: "full", Options: []string{"full", "incremental"}},
+		{Key: "password", Type: plugins.FieldTypeString, Required: true, Secret: true},
+		{Key: "verbose", Type: plugins.FieldTypeBool},
+		{Key: "timeout", Type: plugins.FieldTypeDuration},
+		{Key: "tables", Type: plugins.FieldTypeList},
+		{Key: "labels", Type: plugins.FieldTypeMap},
+		{Key: "auth.token", Type: plugins.FieldTypeString, Required: true, Secret: true, Description: "Token sent with every request"},
+		{Key: "region", Type: plugins.FieldTypeString, Required: true},
+	}, fields)
+}
+
+func TestConfigFieldsWithoutConfig(t *testing.T) {
+	assert.Empty(t, plugins.ConfigFields(nil, ""))
+
+	p := plugins.NewBasePlugin(plugins.Info{}, nil)
+	assert.Empty(t, p.ConfigFields())
+}
@@ -0,0 +1,29 @@
+package plugins
+
+import (
+	"context"
+	"reflect"
+)
+
+// Partition is a part of a source that gets a recipe of its own, such as a
+// database of a server or a project of a cloud account.
+type Partition struct {
+	// Name identifies the partition within the source.
+	Name string
+	// Data holds the values a recipe template needs to extract the partition,
+	// usually keyed by the config key they go into. Credentials are left out.
+	Data map[string]any
+}
+
+// Discoverer is implemented by extractors whose source splits into
+// partitions. Discover takes the credentials of the source, not a full
+// recipe config, and does not require Init.
+type Discoverer interface {
+	Discover(ctx context.Context, config Config) ([]Partition, error)
+}
+
+// DecodeConfig decodes and validates a raw config into the struct pointed
+// to by c, the same way plugins decode their recipe config.
+func DecodeConfig(raw map[string]any, c any) error {
+	return decodeConfig(raw, c, reflect.Indirect(reflect.ValueOf(c)).Type().Name()+".")
+}
@@ -0,0 +1,271 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	pluginv1beta1 "github.com/raystack/meteor/models/raystack/meteor/plugin/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"google.golang.org/grpc"
+)
+
+// infoTimeout bounds the calls that do not work on records: Info while
+// listing plugins, Validate, and Close of sinks.
+var infoTimeout = 10 * time.Second
+
+// lifecycleClient holds the RPCs shared by the generated service clients.
+type lifecycleClient interface {
+	Info(ctx context.Context, in *pluginv1beta1.InfoRequest, opts ...grpc.CallOption) (*pluginv1beta1.InfoResponse, error)
+	Validate(ctx context.Context, in *pluginv1beta1.ValidateRequest, opts ...grpc.CallOption) (*pluginv1beta1.ValidateResponse, error)
+	Init(ctx context.Context, in *pluginv1beta1.InitRequest, opts ...grpc.CallOption) (*pluginv1beta1.InitResponse, error)
+}
+
+// client is the part of a plugin adapter shared by every plugin type. The
+// plugin process is started on first use.
+type client struct {
+	Plugin
+	info func() plugins.Info
+	// newClient wraps a connection in the service client of the plugin type.
+	newClient func(grpc.ClientConnInterface) lifecycleClient
+
+	mu   sync.Mutex
+	proc *process
+	// config is the config the plugin was initialised with, sent again to
+	// the processes started after Init.
+	config *pluginv1beta1.PluginConfig
+}
+
+func newClient(p Plugin, info func() plugins.Info) *client {
+	c := &client{Plugin: p, info: info}
+	switch p.Type {
+	case plugins.PluginTypeExtractor:
+		c.newClient = func(cc grpc.ClientConnInterface) lifecycleClient { return pluginv1beta1.NewExtractorServiceClient(cc) }
+	case plugins.PluginTypeProcessor:
+		c.newClient = func(cc grpc.ClientConnInterface) lifecycleClient { return pluginv1beta1.NewProcessorServiceClient(cc) }
+	case plugins.PluginTypeSink:
+		c.newClient = func(cc grpc.ClientConnInterface) lifecycleClient { return pluginv1beta1.NewSinkServiceClient(cc) }
+	}
+	return c
+}
+
+// conn returns the connection to the plugin process, starting it if needed.
+// A process started after Init, as when an extractor is retried, is
+// initialised with the same config.
+func (c *client) conn(ctx context.Context) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.proc != nil {
+		return c.proc.conn, nil
+	}
+	proc, err := start(c.Path)
+	if err != nil {
+		return nil, err
+	}
+	if c.config != nil {
+		if err := c.init(ctx, proc.conn, c.config); err != nil {
+			proc.stop()
+			return nil, err
+		}
+	}
+	c.proc = proc
+	return proc.conn, nil
+}
+
+func (c *client) init(ctx context.Context, cc grpc.ClientConnInterface, cfg *pluginv1beta1.PluginConfig) error {
+	resp, err := c.newClient(cc).Init(ctx, &pluginv1beta1.InitRequest{Config: cfg})
+	if err != nil {
+		return fromStatus(ctx, err)
+	}
+	return configErrorsFromProto(c.Type, c.Name, resp.GetErrors())
+}
+
+// stop terminates the plugin process, if it is running.
+func (c *client) stop() {
+	c.mu.Lock()
+	proc := c.proc
+	c.proc = nil
+	c.mu.Unlock()
+
+	if proc != nil {
+		proc.stop()
+	}
+}
+
+func (c *client) lifecycle(ctx context.Context) (lifecycleClient, error) {
+	cc, err := c.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.newClient(cc), nil
+}
+
+// Info returns the information reported by the plugin executable.
+func (c *client) Info() plugins.Info {
+	return c.info()
+}
+
+// Validate checks the config against the plugin executable. The process is
+// stopped again unless the plugin was initialised.
+func (c *client) Validate(config plugins.Config) error {
+	cfg, err := configToProto(config)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	initialised := c.config != nil
+	c.mu.Unlock()
+	if !initialised {
+		defer c.stop()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), infoTimeout)
+	defer cancel()
+	lc, err := c.lifecycle(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := lc.Validate(ctx, &pluginv1beta1.ValidateRequest{Config: cfg})
+	if err != nil {
+		return fromStatus(ctx, err)
+	}
+	return configErrorsFromProto(c.Type, c.Name, resp.GetErrors())
+}
+
+// Init initialises the plugin executable. The process is stopped once ctx is done.
+func (c *client) Init(ctx context.Context, config plugins.Config) error {
+	cfg, err := configToProto(config)
+	if err != nil {
+		return err
+	}
+	cc, err := c.conn(ctx)
+	if err != nil {
+		return err
+	}
+	context.AfterFunc(ctx, c.stop)
+
+	if err := c.init(ctx, cc, cfg); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.config = cfg
+	c.mu.Unlock()
+	return nil
+}
+
+// fetchInfo starts the plugin, asks for its information and stops it again.
+func fetchInfo(p Plugin) (plugins.Info, error) {
+	c := newClient(p, nil)
+	defer c.stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), infoTimeout)
+	defer cancel()
+	lc, err := c.lifecycle(ctx)
+	if err != nil {
+		return plugins.Info{}, err
+	}
+	resp, err := lc.Info(ctx, &pluginv1beta1.InfoRequest{})
+	if err != nil {
+		return plugins.Info{}, fmt.Errorf("plugin %s info: %w", p.Path, fromStatus(ctx, err))
+	}
+	return infoFromProto(resp.GetInfo()), nil
+}
+
+type extractorClient struct {
+	*client
+}
+
+// Extract streams records from the plugin executable and stops it once
+// the stream ends. Extracting again, as when the runner retries, starts the
+// executable again.
+func (e *extractorClient) Extract(ctx context.Context, emit plugins.Emit) error {
+	defer e.stop()
+
+	cc, err := e.conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	stream, err := pluginv1beta1.NewExtractorServiceClient(cc).Extract(ctx, &pluginv1beta1.ExtractRequest{})
+	if err != nil {
+		return fromStatus(ctx, err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fromStatus(ctx, err)
+		}
+		emit(recordFromProto(resp.GetRecord()))
+	}
+}
+
+type processorClient struct {
+	*client
+}
+
+// Process sends the record to the plugin executable and returns its result.
+func (p *processorClient) Process(ctx context.Context, src models.Record) (models.Record, error) {
+	cc, err := p.conn(ctx)
+	if err != nil {
+		return models.Record{}, err
+	}
+
+	resp, err := pluginv1beta1.NewProcessorServiceClient(cc).Process(ctx, &pluginv1beta1.ProcessRequest{Record: recordToProto(src)})
+	if err != nil {
+		return models.Record{}, fromStatus(ctx, err)
+	}
+	return recordFromProto(resp.GetRecord()), nil
+}
+
+type sinkClient struct {
+	*client
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Sink sends the batch to the plugin executable.
+func (s *sinkClient) Sink(ctx context.Context, batch []models.Record) error {
+	cc, err := s.conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &pluginv1beta1.SinkRequest{Records: make([]*pluginv1beta1.Record, 0, len(batch))}
+	for _, rec := range batch {
+		req.Records = append(req.Records, recordToProto(rec))
+	}
+	if _, err := pluginv1beta1.NewSinkServiceClient(cc).Sink(ctx, req); err != nil {
+		return fromStatus(ctx, err)
+	}
+	return nil
+}
+
+// Close closes the sink and stops the plugin executable. It is a no-op
+// when the plugin was never started.
+func (s *sinkClient) Close() error {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		proc := s.proc
+		s.mu.Unlock()
+		if proc == nil {
+			return
+		}
+		defer s.stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), infoTimeout)
+		defer cancel()
+		if _, err := pluginv1beta1.NewSinkServiceClient(proc.conn).Close(ctx, &pluginv1beta1.CloseRequest{}); err != nil {
+			s.closeErr = fromStatus(ctx, err)
+		}
+	})
+	return s.closeErr
+}
@@ -0,0 +1,157 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/raystack/meteor/models"
+	pluginv1beta1 "github.com/raystack/meteor/models/raystack/meteor/plugin/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func infoToProto(info plugins.Info) *pluginv1beta1.PluginInfo {
+	pb := &pluginv1beta1.PluginInfo{
+		Description:  info.Description,
+		SampleConfig: info.SampleConfig,
+		Tags:         info.Tags,
+		Summary:      info.Summary,
+	}
+	for _, e := range info.Entities {
+		pb.Entities = append(pb.Entities, &pluginv1beta1.EntityInfo{Type: e.Type, UrnPattern: e.URNPattern})
+	}
+	for _, e := range info.Edges {
+		pb.Edges = append(pb.Edges, &pluginv1beta1.EdgeInfo{Type: e.Type, From: e.From, To: e.To})
+	}
+	return pb
+}
+
+func infoFromProto(pb *pluginv1beta1.PluginInfo) plugins.Info {
+	info := plugins.Info{
+		Description:  pb.GetDescription(),
+		SampleConfig: pb.GetSampleConfig(),
+		Tags:         pb.GetTags(),
+		Summary:      pb.GetSummary(),
+	}
+	for _, e := range pb.GetEntities() {
+		info.Entities = append(info.Entities, plugins.EntityInfo{Type: e.GetType(), URNPattern: e.GetUrnPattern()})
+	}
+	for _, e := range pb.GetEdges() {
+		info.Edges = append(info.Edges, plugins.EdgeInfo{Type: e.GetType(), From: e.GetFrom(), To: e.GetTo()})
+	}
+	return info
+}
+
+func configToProto(cfg plugins.Config) (*pluginv1beta1.PluginConfig, error) {
+	// Round trip through JSON so that typed slices and maps decoded from
+	// YAML become values structpb understands.
+	raw := map[string]any{}
+	if cfg.RawConfig != nil {
+		b, err := json.Marshal(cfg.RawConfig)
+		if err != nil {
+			return nil, fmt.Errorf("marshal config: %w", err)
+		}
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return nil, fmt.Errorf("unmarshal config: %w", err)
+		}
+	}
+
+	s, err := structpb.NewStruct(raw)
+	if err != nil {
+		return nil, fmt.Errorf("convert config: %w", err)
+	}
+	return &pluginv1beta1.PluginConfig{UrnScope: cfg.URNScope, RawConfig: s}, nil
+}
+
+func configFromProto(pb *pluginv1beta1.PluginConfig) plugins.Config {
+	return plugins.Config{
+		URNScope:  pb.GetUrnScope(),
+		RawConfig: pb.GetRawConfig().AsMap(),
+	}
+}
+
+func recordToProto(rec models.Record) *pluginv1beta1.Record {
+	return &pluginv1beta1.Record{Entity: rec.Entity(), Edges: rec.Edges()}
+}
+
+func recordFromProto(pb *pluginv1beta1.Record) models.Record {
+	return models.NewRecord(pb.GetEntity(), pb.GetEdges()...)
+}
+
+// configErrorsToProto extracts the configuration errors from err. The
+// second return value reports whether err was an InvalidConfigError.
+func configErrorsToProto(err error) ([]*pluginv1beta1.ConfigError, bool) {
+	var cfgErr plugins.InvalidConfigError
+	if !errors.As(err, &cfgErr) {
+		return nil, false
+	}
+
+	out := make([]*pluginv1beta1.ConfigError, 0, len(cfgErr.Errors))
+	for _, e := range cfgErr.Errors {
+		out = append(out, &pluginv1beta1.ConfigError{Key: e.Key, Message: e.Message})
+	}
+	if len(out) == 0 {
+		out = append(out, &pluginv1beta1.ConfigError{Message: err.Error()})
+	}
+	return out, true
+}
+
+func configErrorsFromProto(typ plugins.PluginType, name string, pbs []*pluginv1beta1.ConfigError) error {
+	if len(pbs) == 0 {
+		return nil
+	}
+
+	cfgErr := plugins.InvalidConfigError{Type: typ, PluginName: name}
+	for _, e := range pbs {
+		cfgErr.Errors = append(cfgErr.Errors, plugins.ConfigError{Key: e.GetKey(), Message: e.GetMessage()})
+	}
+	return cfgErr
+}
+
+// toStatus turns a plugin error into a gRPC status error. Retryable errors
+// are sent as codes.Unavailable.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.As(err, &plugins.RetryError{}):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+// fromStatus turns a gRPC status error back into a plugin error.
+func fromStatus(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.Unavailable:
+		return plugins.NewRetryError(errors.New(st.Message()))
+	case codes.Canceled:
+		return context.Canceled
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	default:
+		return errors.New(st.Message())
+	}
+}
@@ -0,0 +1,134 @@
+package external
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+)
+
+// Plugin is a plugin executable found in the plugins directory.
+type Plugin struct {
+	Type plugins.PluginType
+	Name string
+	Path string
+}
+
+// Discover lists the plugin executables in dir. Files not named
+// meteor-<type>-<name> or not executable are ignored. A missing directory
+// yields no plugins.
+func Discover(dir string) ([]Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read plugins dir: %w", err)
+	}
+
+	var found []Plugin
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		// Stat follows symlinks, which is how plugins are usually installed.
+		fi, err := os.Stat(path)
+		if err != nil || !fi.Mode().IsRegular() || !isExecutable(fi) {
+			continue
+		}
+
+		typ, name, ok := parseExecutableName(entry.Name())
+		if !ok {
+			continue
+		}
+		found = append(found, Plugin{Type: typ, Name: name, Path: path})
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Path < found[j].Path
+	})
+	return found, nil
+}
+
+// parseExecutableName splits meteor-<type>-<name> into its parts.
+func parseExecutableName(file string) (plugins.PluginType, string, bool) {
+	if runtime.GOOS == "windows" {
+		file = strings.TrimSuffix(file, ".exe")
+	}
+
+	rest, ok := strings.CutPrefix(file, executablePrefix)
+	if !ok {
+		return "", "", false
+	}
+	typ, name, ok := strings.Cut(rest, "-")
+	if !ok || name == "" {
+		return "", "", false
+	}
+
+	switch plugins.PluginType(typ) {
+	case plugins.PluginTypeExtractor, plugins.PluginTypeProcessor, plugins.PluginTypeSink:
+		return plugins.PluginType(typ), name, true
+	default:
+		return "", "", false
+	}
+}
+
+func isExecutable(fi fs.FileInfo) bool {
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(fi.Name()), ".exe")
+	}
+	return fi.Mode().Perm()&0o111 != 0
+}
+
+// Register discovers the plugin executables in dir and registers them in
+// the given factories, next to the built-in plugins. A plugin whose name
+// clashes with an already registered plugin of the same type is skipped
+// and reported in the returned error.
+func Register(dir string, extractors *registry.ExtractorFactory, processors *registry.ProcessorFactory, sinks *registry.SinkFactory) error {
+	found, err := Discover(dir)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, p := range found {
+		info := sync.OnceValue(func() plugins.Info {
+			info, err := fetchInfo(p)
+			if err != nil {
+				info = plugins.Info{Description: fmt.Sprintf("failed to load plugin: %s", err)}
+			}
+			// Tagged so that "meteor plugins list --tag external" finds them.
+			if !slices.Contains(info.Tags, "external") {
+				info.Tags = append(info.Tags, "external")
+			}
+			return info
+		})
+
+		var err error
+		switch p.Type {
+		case plugins.PluginTypeExtractor:
+			err = extractors.Register(p.Name, func() plugins.Extractor {
+				return &extractorClient{client: newClient(p, info)}
+			})
+		case plugins.PluginTypeProcessor:
+			err = processors.Register(p.Name, func() plugins.Processor {
+				return &processorClient{client: newClient(p, info)}
+			})
+		case plugins.PluginTypeSink:
+			err = sinks.Register(p.Name, func() plugins.Syncer {
+				return &sinkClient{client: newClient(p, info)}
+			})
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("register %s: %w", p.Path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,90 @@
+// Package external runs meteor plugins as separate executables that talk
+// to meteor over gRPC. It lets plugins be written and released outside of
+// this repository, in any language that can serve the protocol defined in
+// proto/raystack/meteor/plugin/v1beta1/plugin.proto.
+//
+// A plugin executable is named meteor-<type>-<name>, e.g.
+// meteor-extractor-myservice, and is placed in the plugins directory. When
+// launched by meteor it listens on a local address and prints a single
+// handshake line to stdout:
+//
+//	<protocol version>|<network>|<address>
+//
+// e.g. "1|unix|/tmp/meteor-plugin-123/plugin.sock". Go plugins get all of
+// this for free by calling Serve.
+package external
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/raystack/meteor/plugins"
+)
+
+const (
+	// ProtocolVersion is the version of the handshake and gRPC protocol.
+	ProtocolVersion = 1
+
+	// CookieKey is the environment variable set by meteor when launching a
+	// plugin. It stops plugin executables from being run by hand by mistake.
+	CookieKey = "METEOR_PLUGIN_COOKIE"
+	// CookieValue is the value of CookieKey.
+	CookieValue = "d4e9b1f0-meteor-plugin"
+
+	// executablePrefix is the prefix of every plugin executable name.
+	executablePrefix = "meteor-"
+
+	// maxMessageSize is the largest gRPC message exchanged with plugins.
+	// The gRPC default of 4 MiB is too small for sink batches of large
+	// records, such as tables with preview rows.
+	maxMessageSize = 256 << 20
+)
+
+// handshake is the first line printed by a plugin on stdout.
+type handshake struct {
+	version int
+	network string
+	address string
+}
+
+func (h handshake) String() string {
+	return fmt.Sprintf("%d|%s|%s", h.version, h.network, h.address)
+}
+
+func parseHandshake(line string) (handshake, error) {
+	parts := strings.SplitN(strings.TrimSpace(line), "|", 3)
+	if len(parts) != 3 {
+		return handshake{}, fmt.Errorf("invalid handshake %q: expected <version>|<network>|<address>", line)
+	}
+
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return handshake{}, fmt.Errorf("invalid handshake %q: bad protocol version", line)
+	}
+	if version != ProtocolVersion {
+		return handshake{}, fmt.Errorf("unsupported plugin protocol version %d, meteor supports %d", version, ProtocolVersion)
+	}
+
+	switch parts[1] {
+	case "unix", "tcp":
+	default:
+		return handshake{}, fmt.Errorf("invalid handshake %q: unsupported network %q", line, parts[1])
+	}
+
+	return handshake{version: version, network: parts[1], address: parts[2]}, nil
+}
+
+// pluginType returns the type of p, based on the interfaces it implements.
+func pluginType(p plugins.Plugin) (plugins.PluginType, error) {
+	switch p.(type) {
+	case plugins.Extractor:
+		return plugins.PluginTypeExtractor, nil
+	case plugins.Processor:
+		return plugins.PluginTypeProcessor, nil
+	case plugins.Syncer:
+		return plugins.PluginTypeSink, nil
+	default:
+		return "", fmt.Errorf("%T is not an extractor, processor or sink", p)
+	}
+}
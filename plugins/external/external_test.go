@@ -0,0 +1,351 @@
+//go:build plugins
+// +build plugins
+
+package external_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/external"
+	"github.com/raystack/meteor/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain turns the test binary into a plugin executable when it is
+// launched by meteor through one of the symlinks created by pluginsDir.
+func TestMain(m *testing.M) {
+	if os.Getenv(external.CookieKey) == external.CookieValue {
+		var p plugins.Plugin
+		switch filepath.Base(os.Args[0]) {
+		case "meteor-extractor-fake":
+			p = newFakeExtractor()
+		case "meteor-processor-fake":
+			p = newFakeProcessor()
+		case "meteor-sink-fake":
+			p = newFakeSink()
+		}
+		if err := external.Serve(p); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	code := m.Run()
+	external.Cleanup()
+	os.Exit(code)
+}
+
+func TestDiscover(t *testing.T) {
+	t.Run("should return no plugins for a missing dir", func(t *testing.T) {
+		found, err := external.Discover(filepath.Join(t.TempDir(), "missing"))
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("should only return executables named after a plugin type", func(t *testing.T) {
+		dir := pluginsDir(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "meteor-extractor-notexec"), nil, 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "meteor-widget-foo"), nil, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "meteor-sink-"), nil, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "other"), nil, 0o755))
+
+		found, err := external.Discover(dir)
+		require.NoError(t, err)
+		assert.Equal(t, []external.Plugin{
+			{Type: plugins.PluginTypeExtractor, Name: "fake", Path: filepath.Join(dir, "meteor-extractor-fake")},
+			{Type: plugins.PluginTypeProcessor, Name: "fake", Path: filepath.Join(dir, "meteor-processor-fake")},
+			{Type: plugins.PluginTypeSink, Name: "fake", Path: filepath.Join(dir, "meteor-sink-fake")},
+		}, found)
+	})
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("should list plugins with the info reported by the executable", func(t *testing.T) {
+		extractors, processors, sinks := register(t)
+
+		for typ, info := range map[string]plugins.Info{
+			"extractor": extractors.List()["fake"],
+			"processor": processors.List()["fake"],
+			"sink":      sinks.List()["fake"],
+		} {
+			expected := fakeInfo(typ)
+			expected.Tags = append(expected.Tags, "external")
+			assert.Equal(t, expected, info)
+		}
+	})
+
+	t.Run("should report plugins clashing with registered ones", func(t *testing.T) {
+		extractors := registry.NewExtractorFactory()
+		require.NoError(t, extractors.Register("fake", func() plugins.Extractor { return newFakeExtractor() }))
+
+		err := external.Register(pluginsDir(t), extractors, registry.NewProcessorFactory(), registry.NewSinkFactory())
+		assert.ErrorContains(t, err, "duplicate extractor: fake")
+	})
+}
+
+func TestExtractor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should return config errors from the executable", func(t *testing.T) {
+		extractors, _, _ := register(t)
+		extr, err := extractors.Get("fake")
+		require.NoError(t, err)
+
+		err = extr.Init(ctx, plugins.Config{URNScope: "test", RawConfig: map[string]any{}})
+		var cfgErr plugins.InvalidConfigError
+		require.ErrorAs(t, err, &cfgErr)
+		assert.Equal(t, plugins.PluginTypeExtractor, cfgErr.Type)
+		assert.Equal(t, "fake", cfgErr.PluginName)
+		assert.Equal(t, "count", cfgErr.Errors[0].Key)
+	})
+
+	t.Run("should stream records emitted by the executable", func(t *testing.T) {
+		extractors, _, _ := register(t)
+		extr, err := extractors.Get("fake")
+		require.NoError(t, err)
+		require.NoError(t, extr.Init(ctx, plugins.Config{URNScope: "test", RawConfig: map[string]any{"count": 3}}))
+
+		var urns []string
+		err = extr.Extract(ctx, func(rec models.Record) {
+			urns = append(urns, rec.Entity().GetUrn())
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"urn:fake:test:table:0", "urn:fake:test:table:1", "urn:fake:test:table:2"}, urns)
+	})
+
+	t.Run("should initialise the executable again when extracting again", func(t *testing.T) {
+		extractors, _, _ := register(t)
+		extr, err := extractors.Get("fake")
+		require.NoError(t, err)
+		require.NoError(t, extr.Init(ctx, plugins.Config{URNScope: "test", RawConfig: map[string]any{"count": 2}}))
+
+		for i := 0; i < 2; i++ {
+			var urns []string
+			require.NoError(t, extr.Extract(ctx, func(rec models.Record) {
+				urns = append(urns, rec.Entity().GetUrn())
+			}))
+			assert.Equal(t, []string{"urn:fake:test:table:0", "urn:fake:test:table:1"}, urns)
+		}
+	})
+}
+
+func TestProcessor(t *testing.T) {
+	ctx := context.Background()
+	_, processors, _ := register(t)
+	proc, err := processors.Get("fake")
+	require.NoError(t, err)
+	require.NoError(t, proc.Init(ctx, plugins.Config{RawConfig: map[string]any{"description": "processed"}}))
+
+	t.Run("should return the record processed by the executable", func(t *testing.T) {
+		entity := models.NewEntity("urn:fake:test:table:a", "table", "a", "fake", nil)
+		edge := &meteorv1beta1.Edge{SourceUrn: entity.GetUrn(), TargetUrn: "urn:user:test:user:a", Type: "owned_by"}
+
+		dst, err := proc.Process(ctx, models.NewRecord(entity, edge))
+		require.NoError(t, err)
+		assert.Equal(t, "processed", dst.Entity().GetDescription())
+		require.Len(t, dst.Edges(), 1)
+		assert.Equal(t, "owned_by", dst.Edges()[0].GetType())
+	})
+
+	t.Run("should keep retry errors retryable", func(t *testing.T) {
+		entity := models.NewEntity("urn:fake:test:table:retry", "table", "retry", "fake", nil)
+
+		_, err := proc.Process(ctx, models.NewRecord(entity))
+		assert.ErrorAs(t, err, &plugins.RetryError{})
+		assert.ErrorContains(t, err, "try again")
+	})
+}
+
+func TestSink(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should send batches to the executable", func(t *testing.T) {
+		_, _, sinks := register(t)
+		sink, err := sinks.Get("fake")
+		require.NoError(t, err)
+
+		out := filepath.Join(t.TempDir(), "out.txt")
+		require.NoError(t, sink.Init(ctx, plugins.Config{RawConfig: map[string]any{"path": out}}))
+		require.NoError(t, sink.Sink(ctx, []models.Record{
+			models.NewRecord(models.NewEntity("urn:fake:test:table:a", "table", "a", "fake", nil)),
+			models.NewRecord(models.NewEntity("urn:fake:test:table:b", "table", "b", "fake", nil)),
+		}))
+		require.NoError(t, sink.Sink(ctx, nil))
+		require.NoError(t, sink.Close())
+		require.NoError(t, sink.Close())
+
+		b, err := os.ReadFile(out)
+		require.NoError(t, err)
+		assert.Equal(t, "urn:fake:test:table:a\nurn:fake:test:table:b\nclosed\n", string(b))
+	})
+
+	t.Run("should send batches larger than the gRPC default message size", func(t *testing.T) {
+		_, _, sinks := register(t)
+		sink, err := sinks.Get("fake")
+		require.NoError(t, err)
+
+		out := filepath.Join(t.TempDir(), "out.txt")
+		require.NoError(t, sink.Init(ctx, plugins.Config{RawConfig: map[string]any{"path": out}}))
+		preview := strings.Repeat("x", 5<<20)
+		require.NoError(t, sink.Sink(ctx, []models.Record{
+			models.NewRecord(models.NewEntity("urn:fake:test:table:a", "table", "a", "fake", map[string]any{"preview_rows": preview})),
+		}))
+		require.NoError(t, sink.Close())
+
+		b, err := os.ReadFile(out)
+		require.NoError(t, err)
+		assert.Equal(t, "urn:fake:test:table:a\nclosed\n", string(b))
+	})
+
+	t.Run("should stop the executable started to validate the config", func(t *testing.T) {
+		_, _, sinks := register(t)
+		sink, err := sinks.Get("fake")
+		require.NoError(t, err)
+
+		require.NoError(t, sink.Validate(plugins.Config{RawConfig: map[string]any{"path": "out.txt"}}))
+		// Closing a sink that is still running would fail, as it was never
+		// initialised.
+		assert.NoError(t, sink.Close())
+	})
+
+	t.Run("should not start the executable to close an unused sink", func(t *testing.T) {
+		_, _, sinks := register(t)
+		sink, err := sinks.Get("fake")
+		require.NoError(t, err)
+
+		assert.NoError(t, sink.Close())
+	})
+}
+
+func TestServe(t *testing.T) {
+	t.Run("should refuse to run when not launched by meteor", func(t *testing.T) {
+		err := external.Serve(newFakeExtractor())
+		assert.ErrorIs(t, err, external.ErrNotLaunchedByMeteor)
+	})
+}
+
+// pluginsDir returns a directory with a symlink to the test binary for every plugin type.
+func pluginsDir(t *testing.T) string {
+	t.Helper()
+
+	exe, err := os.Executable()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	for _, name := range []string{"meteor-extractor-fake", "meteor-processor-fake", "meteor-sink-fake"} {
+		require.NoError(t, os.Symlink(exe, filepath.Join(dir, name)))
+	}
+	return dir
+}
+
+func register(t *testing.T) (*registry.ExtractorFactory, *registry.ProcessorFactory, *registry.SinkFactory) {
+	t.Helper()
+
+	extractors, processors, sinks := registry.NewExtractorFactory(), registry.NewProcessorFactory(), registry.NewSinkFactory()
+	require.NoError(t, external.Register(pluginsDir(t), extractors, processors, sinks))
+	return extractors, processors, sinks
+}
+
+func fakeInfo(typ string) plugins.Info {
+	return plugins.Info{
+		Description:  "Fake " + typ + " for tests.",
+		SampleConfig: "count: 1",
+		Tags:         []string{"test"},
+		Entities:     []plugins.EntityInfo{{Type: "table", URNPattern: "urn:fake:{scope}:table:{name}"}},
+		Edges:        []plugins.EdgeInfo{{Type: "owned_by", From: "table", To: "user"}},
+	}
+}
+
+type fakeExtractor struct {
+	plugins.BaseExtractor
+	config struct {
+		Count int `mapstructure:"count" validate:"required"`
+	}
+}
+
+func newFakeExtractor() *fakeExtractor {
+	e := &fakeExtractor{}
+	e.BaseExtractor = plugins.NewBaseExtractor(fakeInfo("extractor"), &e.config)
+	return e
+}
+
+func (e *fakeExtractor) Extract(ctx context.Context, emit plugins.Emit) error {
+	for i := 0; i < e.config.Count; i++ {
+		urn := models.NewURN("fake", e.UrnScope, "table", fmt.Sprint(i))
+		emit(models.NewRecord(models.NewEntity(urn, "table", fmt.Sprint(i), "fake", nil)))
+	}
+	return nil
+}
+
+type fakeProcessor struct {
+	plugins.BasePlugin
+	config struct {
+		Description string `mapstructure:"description" validate:"required"`
+	}
+}
+
+func newFakeProcessor() *fakeProcessor {
+	p := &fakeProcessor{}
+	p.BasePlugin = plugins.NewBasePlugin(fakeInfo("processor"), &p.config)
+	return p
+}
+
+func (p *fakeProcessor) Process(_ context.Context, src models.Record) (models.Record, error) {
+	if strings.HasSuffix(src.Entity().GetUrn(), ":retry") {
+		return models.Record{}, plugins.NewRetryError(errors.New("try again"))
+	}
+
+	src.Entity().Description = p.config.Description
+	return src, nil
+}
+
+type fakeSink struct {
+	plugins.BasePlugin
+	config struct {
+		Path string `mapstructure:"path" validate:"required"`
+	}
+	file *os.File
+}
+
+func newFakeSink() *fakeSink {
+	s := &fakeSink{}
+	s.BasePlugin = plugins.NewBasePlugin(fakeInfo("sink"), &s.config)
+	return s
+}
+
+func (s *fakeSink) Init(ctx context.Context, config plugins.Config) error {
+	if err := s.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	var err error
+	s.file, err = os.Create(s.config.Path)
+	return err
+}
+
+func (s *fakeSink) Sink(_ context.Context, batch []models.Record) error {
+	for _, rec := range batch {
+		if _, err := fmt.Fprintln(s.file, rec.Entity().GetUrn()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	if _, err := fmt.Fprintln(s.file, "closed"); err != nil {
+		return err
+	}
+	return s.file.Close()
+}
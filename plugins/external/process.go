@@ -0,0 +1,164 @@
+package external
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	// startTimeout bounds the time a plugin may take to print its handshake.
+	startTimeout = 10 * time.Second
+	// stopTimeout bounds the time a plugin may take to exit after SIGTERM.
+	stopTimeout = 5 * time.Second
+)
+
+// running holds every plugin process started and not yet stopped.
+var running = struct {
+	mu    sync.Mutex
+	procs map[*process]struct{}
+}{procs: make(map[*process]struct{})}
+
+// process is a running plugin executable and its gRPC connection.
+type process struct {
+	path string
+	cmd  *exec.Cmd
+	conn *grpc.ClientConn
+	done chan struct{}
+
+	stopOnce sync.Once
+}
+
+// start launches the plugin at path and connects to it.
+func start(path string) (*process, error) {
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), CookieKey+"="+CookieValue)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("start plugin %s: %w", path, err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("start plugin %s: %w", path, err)
+	}
+
+	p := &process{path: path, cmd: cmd, done: make(chan struct{})}
+	go func() {
+		_ = cmd.Wait()
+		close(p.done)
+	}()
+
+	lines := make(chan string, 1)
+	go func() {
+		r := bufio.NewReader(stdout)
+		line, err := r.ReadString('\n')
+		if err != nil {
+			close(lines)
+			return
+		}
+		lines <- line
+		// Keep draining stdout so that the plugin never blocks on a full
+		// pipe. stdout of meteor itself may carry command output, so
+		// anything the plugin prints goes to stderr.
+		_, _ = io.Copy(os.Stderr, r)
+	}()
+
+	var line string
+	select {
+	case l, ok := <-lines:
+		if !ok {
+			p.kill()
+			return nil, fmt.Errorf("start plugin %s: exited before handshake", path)
+		}
+		line = l
+	case <-time.After(startTimeout):
+		p.kill()
+		return nil, fmt.Errorf("start plugin %s: no handshake after %s", path, startTimeout)
+	}
+
+	hs, err := parseHandshake(line)
+	if err != nil {
+		p.kill()
+		return nil, fmt.Errorf("start plugin %s: %w", path, err)
+	}
+
+	target := "passthrough:///" + hs.address
+	if hs.network == "unix" {
+		target = "unix://" + hs.address
+	}
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxMessageSize), grpc.MaxCallRecvMsgSize(maxMessageSize)),
+	)
+	if err != nil {
+		p.kill()
+		return nil, fmt.Errorf("connect to plugin %s: %w", path, err)
+	}
+	p.conn = conn
+
+	running.mu.Lock()
+	running.procs[p] = struct{}{}
+	running.mu.Unlock()
+
+	return p, nil
+}
+
+// stop closes the connection and terminates the plugin, killing it if it
+// does not exit in time. It is safe to call more than once.
+func (p *process) stop() {
+	p.stopOnce.Do(func() {
+		running.mu.Lock()
+		delete(running.procs, p)
+		running.mu.Unlock()
+
+		if p.conn != nil {
+			_ = p.conn.Close()
+		}
+		if err := p.cmd.Process.Signal(syscall.SIGTERM); err != nil {
+			p.kill()
+			return
+		}
+		select {
+		case <-p.done:
+		case <-time.After(stopTimeout):
+			p.kill()
+		}
+	})
+}
+
+func (p *process) kill() {
+	if err := p.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return
+	}
+	<-p.done
+}
+
+// Cleanup stops every plugin process that is still running. It should be
+// called before meteor exits.
+func Cleanup() {
+	running.mu.Lock()
+	procs := make([]*process, 0, len(running.procs))
+	for p := range running.procs {
+		procs = append(procs, p)
+	}
+	running.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, p := range procs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.stop()
+		}()
+	}
+	wg.Wait()
+}
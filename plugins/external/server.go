@@ -0,0 +1,214 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/raystack/meteor/models"
+	pluginv1beta1 "github.com/raystack/meteor/models/raystack/meteor/plugin/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"google.golang.org/grpc"
+)
+
+// ErrNotLaunchedByMeteor is returned by Serve when the executable was not
+// started by meteor.
+var ErrNotLaunchedByMeteor = errors.New("this executable is a meteor plugin and is meant to be launched by meteor; " +
+	"place it in the plugins directory and run 'meteor plugins list'")
+
+// Serve serves p over gRPC until meteor stops the process. It is meant to
+// be called from the main function of a plugin executable:
+//
+//	func main() {
+//		if err := external.Serve(myextractor.New(plugins.GetLog())); err != nil {
+//			fmt.Fprintln(os.Stderr, err)
+//			os.Exit(1)
+//		}
+//	}
+func Serve(p plugins.Plugin) error {
+	if os.Getenv(CookieKey) != CookieValue {
+		return ErrNotLaunchedByMeteor
+	}
+
+	dir, err := os.MkdirTemp("", "meteor-plugin-")
+	if err != nil {
+		return fmt.Errorf("create socket dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	lis, err := net.Listen("unix", filepath.Join(dir, "plugin.sock"))
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	srv, err := newServer(p)
+	if err != nil {
+		return err
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sig)
+	go func() {
+		<-sig
+		srv.GracefulStop()
+	}()
+
+	fmt.Fprintln(os.Stdout, handshake{version: ProtocolVersion, network: "unix", address: lis.Addr().String()})
+
+	return srv.Serve(lis)
+}
+
+// newServer returns a gRPC server exposing p with the service matching its type.
+func newServer(p plugins.Plugin) (*grpc.Server, error) {
+	typ, err := pluginType(p)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize))
+	base := pluginServer{plugin: p}
+	switch typ {
+	case plugins.PluginTypeExtractor:
+		pluginv1beta1.RegisterExtractorServiceServer(srv, &extractorServer{pluginServer: base, extractor: p.(plugins.Extractor)})
+	case plugins.PluginTypeProcessor:
+		pluginv1beta1.RegisterProcessorServiceServer(srv, &processorServer{pluginServer: base, processor: p.(plugins.Processor)})
+	case plugins.PluginTypeSink:
+		pluginv1beta1.RegisterSinkServiceServer(srv, &sinkServer{pluginServer: base, sink: p.(plugins.Syncer)})
+	}
+	return srv, nil
+}
+
+// The generated Unimplemented servers are embedded one level deeper than
+// pluginServer, so that its methods take precedence over the stubs.
+type (
+	unimplementedExtractor struct {
+		pluginv1beta1.UnimplementedExtractorServiceServer
+	}
+	unimplementedProcessor struct {
+		pluginv1beta1.UnimplementedProcessorServiceServer
+	}
+	unimplementedSink struct {
+		pluginv1beta1.UnimplementedSinkServiceServer
+	}
+)
+
+// pluginServer implements the RPCs shared by every plugin type.
+type pluginServer struct {
+	plugin plugins.Plugin
+}
+
+func (s pluginServer) Info(context.Context, *pluginv1beta1.InfoRequest) (*pluginv1beta1.InfoResponse, error) {
+	return &pluginv1beta1.InfoResponse{Info: infoToProto(s.plugin.Info())}, nil
+}
+
+func (s pluginServer) Validate(_ context.Context, req *pluginv1beta1.ValidateRequest) (*pluginv1beta1.ValidateResponse, error) {
+	err := s.plugin.Validate(configFromProto(req.GetConfig()))
+	if cfgErrs, ok := configErrorsToProto(err); ok {
+		return &pluginv1beta1.ValidateResponse{Errors: cfgErrs}, nil
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pluginv1beta1.ValidateResponse{}, nil
+}
+
+func (s pluginServer) Init(ctx context.Context, req *pluginv1beta1.InitRequest) (*pluginv1beta1.InitResponse, error) {
+	// The plugin outlives the Init call, so it must not be bound to the
+	// request context.
+	err := s.plugin.Init(context.WithoutCancel(ctx), configFromProto(req.GetConfig()))
+	if cfgErrs, ok := configErrorsToProto(err); ok {
+		return &pluginv1beta1.InitResponse{Errors: cfgErrs}, nil
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pluginv1beta1.InitResponse{}, nil
+}
+
+type extractorServer struct {
+	pluginServer
+	unimplementedExtractor
+	extractor plugins.Extractor
+}
+
+func (s *extractorServer) Extract(_ *pluginv1beta1.ExtractRequest, stream pluginv1beta1.ExtractorService_ExtractServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// Extractors may emit from several goroutines, while a gRPC stream
+	// only supports one concurrent sender.
+	var (
+		mu      sync.Mutex
+		sendErr error
+	)
+	emit := func(rec models.Record) {
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr != nil {
+			return
+		}
+		if err := stream.Send(&pluginv1beta1.ExtractResponse{Record: recordToProto(rec)}); err != nil {
+			sendErr = err
+			cancel()
+		}
+	}
+
+	err := s.extractor.Extract(ctx, emit)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if sendErr != nil {
+		if errors.Is(sendErr, io.EOF) {
+			return nil
+		}
+		return sendErr
+	}
+	return toStatus(err)
+}
+
+type processorServer struct {
+	pluginServer
+	unimplementedProcessor
+	processor plugins.Processor
+}
+
+func (s *processorServer) Process(ctx context.Context, req *pluginv1beta1.ProcessRequest) (*pluginv1beta1.ProcessResponse, error) {
+	dst, err := s.processor.Process(ctx, recordFromProto(req.GetRecord()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pluginv1beta1.ProcessResponse{Record: recordToProto(dst)}, nil
+}
+
+type sinkServer struct {
+	pluginServer
+	unimplementedSink
+	sink plugins.Syncer
+}
+
+func (s *sinkServer) Sink(ctx context.Context, req *pluginv1beta1.SinkRequest) (*pluginv1beta1.SinkResponse, error) {
+	batch := make([]models.Record, 0, len(req.GetRecords()))
+	for _, rec := range req.GetRecords() {
+		batch = append(batch, recordFromProto(rec))
+	}
+
+	if err := s.sink.Sink(ctx, batch); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pluginv1beta1.SinkResponse{}, nil
+}
+
+func (s *sinkServer) Close(context.Context, *pluginv1beta1.CloseRequest) (*pluginv1beta1.CloseResponse, error) {
+	if err := s.sink.Close(); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pluginv1beta1.CloseResponse{}, nil
+}
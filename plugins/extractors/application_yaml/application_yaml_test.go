@@ -11,6 +11,7 @@ import (
 	"github.com/raystack/meteor/models"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -291,3 +292,11 @@ func TestExtract(t *testing.T) {
 		})
 	}
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New:       func() plugins.Extractor { return New(testutils.Logger) },
+		Config:    plugins.Config{URNScope: "test", RawConfig: map[string]any{"file": "testdata/application.detailed.yaml"}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -133,6 +133,7 @@ type Extractor struct {
 	newClient       NewClientFunc
 	randFn          randFn
 	eg              *errgroup.Group
+	discoverOpts    []option.ClientOption
 
 	datasetsDurn       metric.Int64Histogram
 	tablesDurn         metric.Int64Histogram
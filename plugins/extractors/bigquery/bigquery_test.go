@@ -19,6 +19,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/bigquery"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	slog "github.com/raystack/salt/observability/logger"
@@ -269,3 +270,16 @@ func replaceWithStaticTimestamp(_ *testing.T, entity *meteorv1beta1.Entity) {
 		entity.Properties.Fields["update_time"] = structpb.NewStringValue(staticTS)
 	}
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New:    func() plugins.Extractor { return bigquery.New(utils.Logger, bigquery.CreateClient, nil) },
+		Config: plugins.Config{URNScope: "test", RawConfig: map[string]any{"project_id": "test-project"}},
+	}
+	if dockerAvailable {
+		suite.New = func() plugins.Extractor { return bigquery.New(utils.Logger, mockClient, nil) }
+		suite.Config = plugins.Config{URNScope: "test-bigquery", RawConfig: map[string]any{"project_id": projectID}}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
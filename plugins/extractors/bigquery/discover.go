@@ -0,0 +1,71 @@
+package bigquery
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/raystack/meteor/plugins"
+	bqv2 "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+)
+
+// DiscoverConfig holds the credentials used to discover projects. Default
+// credentials are used when neither is set.
+type DiscoverConfig struct {
+	ServiceAccountBase64 string `mapstructure:"service_account_base64"`
+	ServiceAccountJSON   string `mapstructure:"service_account_json"`
+}
+
+// Discover lists the projects the credentials have access to in BigQuery.
+func (e *Extractor) Discover(ctx context.Context, config plugins.Config) ([]plugins.Partition, error) {
+	var cfg DiscoverConfig
+	if err := plugins.DecodeConfig(config.RawConfig, &cfg); err != nil {
+		return nil, err
+	}
+
+	opts := e.discoverOpts
+	if cfg.ServiceAccountBase64 != "" {
+		serviceAccountJSON, err := base64.StdEncoding.DecodeString(cfg.ServiceAccountBase64)
+		if err != nil || len(serviceAccountJSON) == 0 {
+			return nil, fmt.Errorf("decode base64 service account: %w", err)
+		}
+		cfg.ServiceAccountJSON = string(serviceAccountJSON)
+	}
+	if cfg.ServiceAccountJSON != "" {
+		opts = append(opts, option.WithAuthCredentialsJSON(option.ServiceAccount, []byte(cfg.ServiceAccountJSON)))
+	}
+
+	svc, err := bqv2.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create bigquery service: %w", err)
+	}
+
+	var partitions []plugins.Partition
+	err = svc.Projects.List().Pages(ctx, func(page *bqv2.ProjectList) error {
+		for _, p := range page.Projects {
+			id := p.Id
+			if p.ProjectReference != nil {
+				id = p.ProjectReference.ProjectId
+			}
+			partitions = append(partitions, plugins.Partition{
+				Name: id,
+				Data: map[string]any{
+					"project_id":    id,
+					"friendly_name": p.FriendlyName,
+				},
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+
+	return partitions, nil
+}
+
+// SetDiscoverOptions sets the client options used by Discover (used for testing).
+func (e *Extractor) SetDiscoverOptions(opts ...option.ClientOption) {
+	e.discoverOpts = opts
+}
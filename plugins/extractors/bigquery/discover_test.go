@@ -0,0 +1,51 @@
+//go:build plugins
+// +build plugins
+
+package bigquery_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/extractors/bigquery"
+	"github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+func TestDiscover(t *testing.T) {
+	t.Run("should return error for invalid base64 service account", func(t *testing.T) {
+		extr := bigquery.New(utils.Logger, bigquery.CreateClient, nil)
+		_, err := extr.Discover(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{"service_account_base64": "not base64"},
+		})
+		assert.ErrorContains(t, err, "decode base64 service account")
+	})
+
+	t.Run("should list the projects across pages", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/projects", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("pageToken") == "" {
+				_, _ = w.Write([]byte(`{"projects": [{"id": "analytics", "friendlyName": "Analytics", "projectReference": {"projectId": "analytics"}}], "nextPageToken": "2"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"projects": [{"id": "billing", "projectReference": {"projectId": "billing"}}]}`))
+		}))
+		defer server.Close()
+
+		extr := bigquery.New(utils.Logger, bigquery.CreateClient, nil)
+		extr.SetDiscoverOptions(option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+		partitions, err := extr.Discover(context.TODO(), plugins.Config{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []plugins.Partition{
+			{Name: "analytics", Data: map[string]any{"project_id": "analytics", "friendly_name": "Analytics"}},
+			{Name: "billing", Data: map[string]any{"project_id": "billing", "friendly_name": ""}},
+		}, partitions)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/raystack/meteor/plugins"
 	bt "github.com/raystack/meteor/plugins/extractors/bigtable"
 	btMocks "github.com/raystack/meteor/plugins/extractors/bigtable/mocks"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -238,3 +239,35 @@ func TestExtract(t *testing.T) {
 		assert.Len(t, emitter.GetAllEntities(), 0)
 	})
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor {
+			instanceAdminClient := func(ctx context.Context, cfg bt.Config) (bt.InstanceAdminClient, error) {
+				m := btMocks.NewInstanceAdminClient(t)
+				m.On("Instances", mock.Anything).Return([]*bigtable.InstanceInfo{
+					{Name: "instance-A"},
+				}, nil).Maybe()
+				return m, nil
+			}
+			adminClient := func(ctx context.Context, instance string, config bt.Config) (bt.AdminClient, error) {
+				m := btMocks.NewAdminClient(t)
+				m.On("Tables", mock.Anything).Return([]string{"table-a"}, nil).Maybe()
+				m.On("TableInfo", mock.Anything, mock.Anything).Return(&bigtable.TableInfo{
+					FamilyInfos: []bigtable.FamilyInfo{
+						{Name: "family-a"},
+					},
+				}, nil).Maybe()
+				return m, nil
+			}
+			return bt.New(utils.Logger, instanceAdminClient, adminClient)
+		},
+		Config: plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"project_id": "google-project-id",
+			},
+		},
+		Reachable: true,
+	}.Run(t)
+}
@@ -19,6 +19,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/cassandra"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -210,3 +211,22 @@ func getExpected() []*meteorv1beta1.Entity {
 		}),
 	}
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return cassandra.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"user_id":  user,
+				"password": pass,
+				"host":     host,
+				"port":     port,
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -19,6 +19,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/clickhouse"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -178,3 +179,19 @@ func execute(db *sql.DB, queries []string) (err error) {
 func newExtractor() *clickhouse.Extractor {
 	return clickhouse.New(utils.Logger)
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return clickhouse.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("tcp://%s?username=default&password=%s&debug=true", host, pass),
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -47,9 +47,11 @@ var info = plugins.Info{
 	Summary:      summary,
 	Tags:         []string{"saas", "collaboration"},
 	Entities: []plugins.EntityInfo{
+		{Type: "space", URNPattern: "urn:confluence:{scope}:space:{space_key}"},
 		{Type: "document", URNPattern: "urn:confluence:{scope}:document:{page_id}"},
 	},
 	Edges: []plugins.EdgeInfo{
+		{Type: "belongs_to", From: "document", To: "space"},
 		{Type: "child_of", From: "document", To: "document"},
 		{Type: "owned_by", From: "document", To: "user"},
 	},
@@ -14,6 +14,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	extractor "github.com/raystack/meteor/plugins/extractors/confluence"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -342,3 +343,21 @@ func writeJSON(w http.ResponseWriter, v any) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(v) //nolint:errcheck
 }
+
+func TestConformance(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return extractor.New(testutils.Logger) },
+		Config: plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"base_url": server.URL,
+				"username": "user@test.com",
+				"token":    "test-token",
+			},
+		},
+		Reachable: true,
+	}.Run(t)
+}
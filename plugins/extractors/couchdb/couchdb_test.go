@@ -20,6 +20,7 @@ import (
 	"github.com/ory/dockertest/v3/docker"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/couchdb"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -172,3 +173,19 @@ func mockdata(dbName string) (mockSetupData []map[string]any) {
 	}
 	return
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return couchdb.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("http://%s:%s@%s/", user, pass, host),
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
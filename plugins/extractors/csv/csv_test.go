@@ -11,6 +11,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/csv"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -96,3 +97,11 @@ func TestExtract(t *testing.T) {
 		utils.AssertEqualProtos(t, expected, emitter.GetAllEntities())
 	})
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New:       func() plugins.Extractor { return csv.New(utils.Logger) },
+		Config:    plugins.Config{URNScope: "test", RawConfig: map[string]any{"path": "./testdata"}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -11,6 +11,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	extractor "github.com/raystack/meteor/plugins/extractors/dbt"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -238,3 +239,11 @@ func findColumn(columns []any, name string) map[string]any {
 	}
 	return nil
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New:       func() plugins.Extractor { return extractor.New(testutils.Logger) },
+		Config:    plugins.Config{URNScope: "test", RawConfig: map[string]any{"manifest": "testdata/manifest.json", "catalog": "testdata/catalog.json"}},
+		Reachable: true,
+	}.Run(t)
+}
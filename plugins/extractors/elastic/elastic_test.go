@@ -21,6 +21,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/elastic"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -214,3 +215,21 @@ func getExpectedVal(t *testing.T) []*meteorv1beta1.Entity {
 		}),
 	}
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return elastic.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"host":     host,
+				"user":     user,
+				"password": pass,
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ory/dockertest/v3/docker"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	slog "github.com/raystack/salt/observability/logger"
@@ -190,3 +191,15 @@ func replaceWithStaticTimestamp(t *testing.T, actual []*meteorv1beta1.Entity) {
 	assert.NoError(t, err)
 	actual[0].Properties = newProps
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return New(utils.Logger, createClient) },
+	}
+	if dockerAvailable {
+		suite.New = func() plugins.Extractor { return New(utils.Logger, mockClient) }
+		suite.Config = plugins.Config{URNScope: "test-gcs", RawConfig: map[string]any{"project_id": "google-project-id"}}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	gh "github.com/google/go-github/v68/github"
+	"github.com/raystack/meteor/plugins"
+	"golang.org/x/oauth2"
+)
+
+// DiscoverConfig holds the token used to discover organisations.
+type DiscoverConfig struct {
+	Token string `mapstructure:"token" validate:"required"`
+}
+
+// Discover lists the organisations the owner of the token is a member of.
+func (e *Extractor) Discover(ctx context.Context, config plugins.Config) ([]plugins.Partition, error) {
+	var cfg DiscoverConfig
+	if err := plugins.DecodeConfig(config.RawConfig, &cfg); err != nil {
+		return nil, err
+	}
+	client := e.newClient(ctx, cfg.Token)
+
+	var partitions []plugins.Partition
+	opts := &gh.ListOptions{PerPage: 100}
+	for {
+		orgs, resp, err := client.Organizations.List(ctx, "", opts)
+		if err != nil {
+			return nil, fmt.Errorf("list organisations: %w", err)
+		}
+		for _, org := range orgs {
+			partitions = append(partitions, plugins.Partition{
+				Name: org.GetLogin(),
+				Data: map[string]any{"org": org.GetLogin()},
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return partitions, nil
+}
+
+func (e *Extractor) newClient(ctx context.Context, token string) *gh.Client {
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	client := gh.NewClient(oauth2.NewClient(ctx, ts))
+	if e.baseURL != "" {
+		client.BaseURL, _ = client.BaseURL.Parse(e.baseURL + "/api/v3/")
+	}
+	return client
+}
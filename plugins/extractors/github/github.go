@@ -13,7 +13,6 @@ import (
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/registry"
 	log "github.com/raystack/salt/observability/logger"
-	"golang.org/x/oauth2"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -78,6 +77,7 @@ type Extractor struct {
 	logger  log.Logger
 	config  Config
 	client  *gh.Client
+	baseURL string
 	extract map[string]bool
 }
 
@@ -92,9 +92,7 @@ func (e *Extractor) Init(ctx context.Context, config plugins.Config) error {
 		return err
 	}
 
-	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: e.config.Token})
-	tc := oauth2.NewClient(ctx, ts)
-	e.client = gh.NewClient(tc)
+	e.client = e.newClient(ctx, e.config.Token)
 
 	e.extract = map[string]bool{
 		"users":         true,
@@ -115,7 +113,10 @@ func (e *Extractor) Init(ctx context.Context, config plugins.Config) error {
 
 // SetBaseURL overrides the GitHub API base URL (used for testing).
 func (e *Extractor) SetBaseURL(url string) {
-	e.client.BaseURL, _ = e.client.BaseURL.Parse(url + "/api/v3/")
+	e.baseURL = url
+	if e.client != nil {
+		e.client.BaseURL, _ = e.client.BaseURL.Parse(url + "/api/v3/")
+	}
 }
 
 func (e *Extractor) Extract(ctx context.Context, emit plugins.Emit) error {
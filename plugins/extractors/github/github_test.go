@@ -16,6 +16,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	extractor "github.com/raystack/meteor/plugins/extractors/github"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -607,6 +608,40 @@ func initExtractor(t *testing.T, serverURL string, extraConfig map[string]any) *
 	return extr
 }
 
+func TestDiscover(t *testing.T) {
+	t.Run("should return error when token is missing", func(t *testing.T) {
+		_, err := extractor.New(testutils.Logger).Discover(context.TODO(), plugins.Config{})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should list the organisations of the token owner across pages", func(t *testing.T) {
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v3/user/orgs", r.URL.Path)
+			assert.Equal(t, "Bearer some-token", r.Header.Get("Authorization"))
+			if r.URL.Query().Get("page") == "" {
+				w.Header().Set("Link", `<`+server.URL+`/api/v3/user/orgs?page=2>; rel="next"`)
+				writeJSON(w, []*gh.Organization{{Login: strPtr("raystack")}})
+				return
+			}
+			writeJSON(w, []*gh.Organization{{Login: strPtr("goto")}})
+		}))
+		defer server.Close()
+
+		extr := extractor.New(testutils.Logger)
+		extr.SetBaseURL(server.URL)
+		partitions, err := extr.Discover(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{"token": "some-token"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []plugins.Partition{
+			{Name: "raystack", Data: map[string]any{"org": "raystack"}},
+			{Name: "goto", Data: map[string]any{"org": "goto"}},
+		}, partitions)
+	})
+}
+
 type serverConfig struct {
 	members           []*gh.User
 	userDetails       map[string]*gh.User
@@ -760,3 +795,30 @@ func findEdgeBySource(edges []*meteorv1beta1.Edge, sourceURN string) *meteorv1be
 	return nil
 }
 
+func TestConformance(t *testing.T) {
+	server := setupServer(t, serverConfig{
+		members:     []*gh.User{{Login: strPtr("alice"), NodeID: strPtr("U_alice")}},
+		userDetails: map[string]*gh.User{"alice": {NodeID: strPtr("U_alice"), Login: strPtr("alice")}},
+		repos: []*gh.Repository{{
+			NodeID: strPtr("R_repo1"),
+			Name:   strPtr("meteor"),
+			Owner:  &gh.User{NodeID: strPtr("U_alice")},
+		}},
+		teams:       []*gh.Team{{NodeID: strPtr("T_team1"), Slug: strPtr("data"), Name: strPtr("Data")}},
+		teamMembers: map[string][]*gh.User{"data": {{Login: strPtr("alice"), NodeID: strPtr("U_alice")}}},
+	})
+	defer server.Close()
+
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor {
+			extr := extractor.New(testutils.Logger)
+			extr.SetBaseURL(server.URL)
+			return extr
+		},
+		Config: plugins.Config{
+			URNScope:  urnScope,
+			RawConfig: map[string]any{"org": "my-org", "token": "test-token"},
+		},
+		Reachable: true,
+	}.Run(t)
+}
@@ -15,6 +15,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/grafana"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -637,3 +638,17 @@ func NewTestServer() *httptest.Server {
 	})
 	return httptest.NewServer(mux)
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return grafana.New(utils.Logger) },
+		Config: plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"base_url": testServer.URL,
+				"api_key":  "qwerty123",
+			},
+		},
+		Reachable: true,
+	}.Run(t)
+}
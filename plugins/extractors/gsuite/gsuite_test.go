@@ -11,6 +11,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/gsuite"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -221,3 +222,21 @@ func (m *mockUsersListCall) Do(opts ...googleapi.CallOption) (*admin.Users, erro
 	}
 	return args.Get(0).(*admin.Users), args.Error(1)
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor {
+			userService := new(mockUsersListCall)
+			userService.On("Do").Return(&admin.Users{Users: []*admin.User{{
+				PrimaryEmail: "user@example.com",
+				Name:         &admin.UserName{FullName: "Example User"},
+			}}}, nil)
+
+			factory := new(mockUsersServiceFactory)
+			factory.On("BuildUserService", mock.Anything, "user@example.com", "{}").Return(userService, nil)
+			return gsuite.New(utils.Logger, factory)
+		},
+		Config:    plugins.Config{URNScope: "test", RawConfig: map[string]any{"service_account_json": "{}", "user_email": "user@example.com"}},
+		Reachable: true,
+	}.Run(t)
+}
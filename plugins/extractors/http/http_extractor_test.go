@@ -15,6 +15,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -965,3 +966,32 @@ func replaceServerURL(cfg map[string]any, serverURL string) {
 		beforeScriptCfg["source"] = strings.Replace(beforeScriptCfg["source"].(string), "{{serverURL}}", serverURL, -1)
 	}
 }
+
+func TestConformance(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testutils.Respond(t, w, http.StatusOK, `{"employee_id": "1", "fullname": "Alice"}`)
+	}))
+	defer srv.Close()
+
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return New(testutils.Logger) },
+		Config: plugins.Config{URNScope: urnScope, RawConfig: map[string]any{
+			"request": map[string]any{
+				"url":          srv.URL + "/api/v1/users",
+				"content_type": "application/json",
+				"accept":       "application/json",
+			},
+			"script": map[string]any{
+				"engine": "tengo",
+				"source": heredoc.Doc(`
+					entity := new_entity("user")
+					entity.urn = format("urn:%s:%s:user:%s", "my_usr_svc", recipe_scope, response.body.employee_id)
+					entity.name = response.body.fullname
+					emit(entity)
+				`),
+			},
+		}},
+		NoEntities: true,
+		Reachable:  true,
+	}.Run(t)
+}
@@ -0,0 +1,57 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/raystack/meteor/plugins"
+)
+
+// DiscoverConfig lists the clusters to discover.
+type DiscoverConfig struct {
+	// Brokers holds one bootstrap address per cluster. Addresses of the
+	// same cluster are separated by commas.
+	Brokers []string   `mapstructure:"brokers" validate:"required,min=1"`
+	Auth    AuthConfig `mapstructure:"auth_config"`
+}
+
+// Discover checks that each of the configured clusters can be reached and
+// returns them as partitions.
+func (e *Extractor) Discover(ctx context.Context, config plugins.Config) ([]plugins.Partition, error) {
+	var cfg DiscoverConfig
+	if err := plugins.DecodeConfig(config.RawConfig, &cfg); err != nil {
+		return nil, err
+	}
+	saramaConfig, err := newSaramaConfig(cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	var partitions []plugins.Partition
+	for _, broker := range cfg.Brokers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := pingCluster(broker, saramaConfig); err != nil {
+			return nil, fmt.Errorf("reach kafka cluster %s: %w", broker, err)
+		}
+		partitions = append(partitions, plugins.Partition{
+			Name: plugins.KafkaServersToScope(broker),
+			Data: map[string]any{"broker": broker},
+		})
+	}
+	return partitions, nil
+}
+
+func pingCluster(broker string, cfg *sarama.Config) error {
+	admin, err := sarama.NewClusterAdmin(strings.Split(broker, ","), cfg)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	_, _, err = admin.DescribeCluster()
+	return err
+}
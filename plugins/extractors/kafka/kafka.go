@@ -113,22 +113,9 @@ func (e *Extractor) Init(ctx context.Context, config plugins.Config) error {
 		return err
 	}
 
-	consumerConfig := sarama.NewConfig()
-	if e.config.Auth.TLS.Enabled {
-		tlsConfig, err := e.createTLSConfig()
-		if err != nil {
-			return fmt.Errorf("create tls config: %w", err)
-		}
-		consumerConfig.Net.TLS.Enable = true
-		consumerConfig.Net.TLS.Config = tlsConfig
-	}
-
-	if e.config.Auth.SASL.Enabled {
-		consumerConfig.Net.SASL.Enable = true
-		if e.config.Auth.SASL.Mechanism == sarama.SASLTypeOAuth {
-			consumerConfig.Net.SASL.Mechanism = sarama.SASLTypeOAuth
-			consumerConfig.Net.SASL.TokenProvider = NewKubernetesTokenProvider()
-		}
+	consumerConfig, err := newSaramaConfig(e.config.Auth)
+	if err != nil {
+		return err
 	}
 
 	consumer, err := sarama.NewConsumer([]string{e.config.Broker}, consumerConfig)
@@ -347,13 +334,35 @@ func (e *Extractor) buildConsumerGroupRecord(desc *sarama.GroupDescription) mode
 	return models.NewRecord(entity, edges...)
 }
 
-func (e *Extractor) createTLSConfig() (*tls.Config, error) {
-	authConfig := e.config.Auth.TLS
+// newSaramaConfig creates the client config for the given auth config.
+func newSaramaConfig(auth AuthConfig) (*sarama.Config, error) {
+	cfg := sarama.NewConfig()
+	if auth.TLS.Enabled {
+		tlsConfig, err := createTLSConfig(auth)
+		if err != nil {
+			return nil, fmt.Errorf("create tls config: %w", err)
+		}
+		cfg.Net.TLS.Enable = true
+		cfg.Net.TLS.Config = tlsConfig
+	}
+
+	if auth.SASL.Enabled {
+		cfg.Net.SASL.Enable = true
+		if auth.SASL.Mechanism == sarama.SASLTypeOAuth {
+			cfg.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+			cfg.Net.SASL.TokenProvider = NewKubernetesTokenProvider()
+		}
+	}
+	return cfg, nil
+}
+
+func createTLSConfig(auth AuthConfig) (*tls.Config, error) {
+	authConfig := auth.TLS
 
 	if authConfig.CAFile == "" {
 		//nolint:gosec
 		return &tls.Config{
-			InsecureSkipVerify: e.config.Auth.TLS.InsecureSkipVerify,
+			InsecureSkipVerify: authConfig.InsecureSkipVerify,
 		}, nil
 	}
 
@@ -378,7 +387,7 @@ func (e *Extractor) createTLSConfig() (*tls.Config, error) {
 	return &tls.Config{
 		Certificates:       []tls.Certificate{cert},
 		RootCAs:            caCertPool,
-		InsecureSkipVerify: e.config.Auth.TLS.InsecureSkipVerify,
+		InsecureSkipVerify: authConfig.InsecureSkipVerify,
 	}, nil
 }
 
@@ -18,6 +18,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/kafka"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -165,6 +166,31 @@ func TestInit(t *testing.T) {
 	})
 }
 
+func TestDiscover(t *testing.T) {
+	t.Run("should return error when no brokers are given", func(t *testing.T) {
+		_, err := newExtractor().Discover(context.TODO(), plugins.Config{})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return each reachable cluster", func(t *testing.T) {
+		utils.SkipIfNoDocker(t, dockerAvailable)
+		partitions, err := newExtractor().Discover(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{"brokers": []string{brokerHost}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []plugins.Partition{
+			{Name: plugins.KafkaServersToScope(brokerHost), Data: map[string]any{"broker": brokerHost}},
+		}, partitions)
+	})
+
+	t.Run("should return error for an unreachable cluster", func(t *testing.T) {
+		_, err := newExtractor().Discover(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{"brokers": []string{"127.0.0.1:1"}},
+		})
+		assert.ErrorContains(t, err, "reach kafka cluster 127.0.0.1:1")
+	})
+}
+
 func TestExtract(t *testing.T) {
 	utils.SkipIfNoDocker(t, dockerAvailable)
 	t.Run("should emit list of topic metadata", func(t *testing.T) {
@@ -262,3 +288,14 @@ func assertResults(t *testing.T, expected, result []models.Record) {
 		delete(expectedMap, entity.GetUrn())
 	}
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return kafka.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{URNScope: urnScope, RawConfig: map[string]any{"broker": brokerHost}}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
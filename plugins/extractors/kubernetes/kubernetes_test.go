@@ -0,0 +1,22 @@
+//go:build plugins
+// +build plugins
+
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/extractors/kubernetes"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/test/utils"
+)
+
+// Extract is not checked: the extractor needs a live cluster or kubeconfig
+// and the package has no fake API server.
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New:              func() plugins.Extractor { return kubernetes.New(utils.Logger) },
+		EmptyConfigValid: true,
+	}.Run(t)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ory/dockertest/v3/docker"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/mariadb"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -161,3 +162,19 @@ func execute(db *sql.DB, queries []string) (err error) {
 	}
 	return
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return mariadb.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("%s:%s@tcp(%s)/", user, pass, host),
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
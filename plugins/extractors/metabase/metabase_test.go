@@ -15,6 +15,7 @@ import (
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/metabase"
 	m "github.com/raystack/meteor/plugins/extractors/metabase/models"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -262,3 +263,35 @@ func (c *mockClient) GetTable(ctx context.Context, id int) (m.Table, error) {
 	args := c.Called(id)
 	return args.Get(0).(m.Table), args.Error(1)
 }
+
+func TestConformance(t *testing.T) {
+	dashboards, dashboard1 := getDashboardList(t), getDashboard(t, 1)
+	tables := map[int]m.Table{2: getTable(t, 2), 5: getTable(t, 5)}
+	databases := map[int]m.Database{2: getDatabase(t, 2), 3: getDatabase(t, 3)}
+
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor {
+			client := new(mockClient)
+			client.On("Authenticate", host, "test-user", "test-pass", "").Return(nil)
+			client.On("GetDashboards").Return(dashboards, nil)
+			client.On("GetDashboard", 1).Return(dashboard1, nil)
+			for id, table := range tables {
+				client.On("GetTable", id).Return(table, nil)
+			}
+			for id, database := range databases {
+				client.On("GetDatabase", id).Return(database, nil)
+			}
+			return metabase.New(client, testutils.Logger)
+		},
+		Config: plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"host":           host,
+				"username":       "test-user",
+				"password":       "test-pass",
+				"instance_label": "my-metabase",
+			},
+		},
+		Reachable: true,
+	}.Run(t)
+}
@@ -0,0 +1,261 @@
+package meteor
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	_ "embed" // used to print the embedded assets
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed README.md
+var summary string
+
+// Config holds the configuration for the meteor extractor
+type Config struct {
+	// Path is a file, a directory or a glob pattern.
+	Path string `mapstructure:"path" validate:"required"`
+	// Format is inferred from the file extension when empty.
+	Format string `mapstructure:"format" validate:"omitempty,oneof=ndjson yaml"`
+}
+
+var sampleConfig = heredoc.Doc(`
+	# file, directory or glob; gzipped files (.gz) are supported
+	path: ./snapshots/*.ndjson.gz
+	# ndjson or yaml, inferred from the file extension if omitted
+	format: ndjson
+`)
+
+var info = plugins.Info{
+	Description:  "Replay records written by the file sink.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"file"},
+}
+
+// maxLineSize bounds a single ndjson record.
+const maxLineSize = 64 * 1024 * 1024
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// Extractor replays records from files written by the file sink.
+type Extractor struct {
+	plugins.BaseExtractor
+	config    Config
+	logger    log.Logger
+	filePaths []string
+}
+
+// New returns a pointer to an initialized Extractor Object
+func New(logger log.Logger) *Extractor {
+	e := &Extractor{
+		logger: logger,
+	}
+	e.BaseExtractor = plugins.NewBaseExtractor(info, &e.config)
+	e.ScopeNotRequired = true
+
+	return e
+}
+
+func (e *Extractor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = e.BaseExtractor.Init(ctx, config); err != nil {
+		return err
+	}
+
+	e.filePaths, err = buildFilePaths(e.config.Path)
+	if err != nil {
+		return fmt.Errorf("resolve path %q: %w", e.config.Path, err)
+	}
+	if len(e.filePaths) == 0 {
+		return fmt.Errorf("no files found for path %q", e.config.Path)
+	}
+
+	return nil
+}
+
+// Extract emits every record found in the configured files.
+func (e *Extractor) Extract(ctx context.Context, emit plugins.Emit) error {
+	for _, filePath := range e.filePaths {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		e.logger.Debug("replaying file", "path", filePath)
+		if err := e.extractFile(ctx, filePath, emit); err != nil {
+			return fmt.Errorf("replay %q: %w", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+func (e *Extractor) extractFile(ctx context.Context, filePath string, emit plugins.Emit) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := decompress(f)
+	if err != nil {
+		return err
+	}
+
+	format := e.config.Format
+	if format == "" {
+		format = formatFromPath(filePath)
+	}
+
+	if format == "yaml" {
+		return extractYAML(ctx, r, emit)
+	}
+	return extractNDJSON(ctx, r, emit)
+}
+
+func extractNDJSON(ctx context.Context, r io.Reader, emit plugins.Emit) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		b := bytes.TrimSpace(scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		rec, err := models.RecordFromJSON(b)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		emit(rec)
+	}
+
+	return scanner.Err()
+}
+
+// extractYAML reads the yaml output of the file sink: a sequence of
+// records in the same shape as models.RecordToJSON.
+func extractYAML(ctx context.Context, r io.Reader, emit plugins.Emit) error {
+	var items []map[string]any
+	if err := yaml.NewDecoder(r).Decode(&items); err != nil && err != io.EOF {
+		return fmt.Errorf("decode yaml: %w", err)
+	}
+
+	for i, item := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		b, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("record %d: %w", i, err)
+		}
+		rec, err := models.RecordFromJSON(b)
+		if err != nil {
+			return fmt.Errorf("record %d: %w", i, err)
+		}
+		emit(rec)
+	}
+
+	return nil
+}
+
+// decompress transparently unwraps gzip content, detected by its magic bytes.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return br, nil
+	}
+
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, fmt.Errorf("open gzip: %w", err)
+	}
+	return gz, nil
+}
+
+func formatFromPath(filePath string) string {
+	switch filepath.Ext(strings.TrimSuffix(filePath, ".gz")) {
+	case ".yaml", ".yml":
+		return "yaml"
+	default:
+		return "ndjson"
+	}
+}
+
+// buildFilePaths resolves a file, a directory or a glob pattern into a sorted list of files.
+// Directories are not walked recursively and only known extensions are picked up.
+func buildFilePaths(path string) ([]string, error) {
+	if strings.ContainsAny(path, "*?[") {
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, err
+		}
+		var files []string
+		for _, m := range matches {
+			if fi, err := os.Stat(m); err == nil && fi.Mode().IsRegular() {
+				files = append(files, m)
+			}
+		}
+		sort.Strings(files)
+		return files, nil
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || !hasKnownExt(entry.Name()) {
+			continue
+		}
+		files = append(files, filepath.Join(path, entry.Name()))
+	}
+	return files, nil
+}
+
+func hasKnownExt(name string) bool {
+	switch filepath.Ext(strings.TrimSuffix(name, ".gz")) {
+	case ".ndjson", ".json", ".jsonl", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// Register the extractor to catalog
+func init() {
+	if err := registry.Extractors.Register("meteor", func() plugins.Extractor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,119 @@
+//go:build plugins
+// +build plugins
+
+package meteor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/extractors/meteor"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/test/mocks"
+	"github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedEntities = []*meteorv1beta1.Entity{
+	models.NewEntity("urn:bigquery:p:table:p:d.orders", "table", "orders", "bigquery", map[string]any{
+		"columns": []any{map[string]any{"name": "id", "data_type": "INT64"}},
+	}),
+	models.NewEntity("urn:bigquery:p:table:p:d.users", "table", "users", "bigquery", nil),
+}
+
+var expectedEdges = []*meteorv1beta1.Edge{
+	models.OwnerEdge("urn:bigquery:p:table:p:d.orders", "urn:user:alice", "bigquery"),
+}
+
+func TestInit(t *testing.T) {
+	t.Run("should return error if path is empty", func(t *testing.T) {
+		err := meteor.New(utils.Logger).Init(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{},
+		})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return error for unknown format", func(t *testing.T) {
+		err := meteor.New(utils.Logger).Init(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{"path": "./testdata/records.ndjson", "format": "csv"},
+		})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return error if glob matches nothing", func(t *testing.T) {
+		err := meteor.New(utils.Logger).Init(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{"path": "./testdata/*.missing"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should not require scope", func(t *testing.T) {
+		err := meteor.New(utils.Logger).Init(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{"path": "./testdata/records.ndjson"},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestExtract(t *testing.T) {
+	cases := []struct {
+		name   string
+		config map[string]any
+	}{
+		{name: "should replay ndjson file", config: map[string]any{"path": "./testdata/records.ndjson"}},
+		{name: "should replay yaml file", config: map[string]any{"path": "./testdata/records.yaml"}},
+		{name: "should replay gzipped files matched by glob", config: map[string]any{"path": "./testdata/gzipped/*.gz"}},
+		{name: "should replay known files in directory", config: map[string]any{"path": "./testdata/gzipped"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			extr := meteor.New(utils.Logger)
+			require.NoError(t, extr.Init(ctx, plugins.Config{RawConfig: tc.config}))
+
+			emitter := mocks.NewEmitter()
+			err := extr.Extract(ctx, emitter.Push)
+			require.NoError(t, err)
+
+			utils.AssertEqualProtos(t, expectedEntities, emitter.GetAllEntities())
+			utils.AssertEqualProtos(t, expectedEdges, emitter.GetAllEdges())
+		})
+	}
+
+	t.Run("should return error on malformed record", func(t *testing.T) {
+		ctx := context.TODO()
+		extr := meteor.New(utils.Logger)
+		require.NoError(t, extr.Init(ctx, plugins.Config{
+			RawConfig: map[string]any{"path": "./testdata/gzipped/notes.txt", "format": "ndjson"},
+		}))
+
+		err := extr.Extract(ctx, mocks.NewEmitter().Push)
+		assert.ErrorContains(t, err, "line 1")
+	})
+
+	t.Run("should stop when context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		extr := meteor.New(utils.Logger)
+		require.NoError(t, extr.Init(ctx, plugins.Config{
+			RawConfig: map[string]any{"path": "./testdata/records.ndjson"},
+		}))
+		cancel()
+
+		err := extr.Extract(ctx, mocks.NewEmitter().Push)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New:        func() plugins.Extractor { return meteor.New(utils.Logger) },
+		Config:     plugins.Config{URNScope: "test", RawConfig: map[string]any{"path": "testdata"}},
+		NoEntities: true,
+		Reachable:  true,
+	}.Run(t)
+}
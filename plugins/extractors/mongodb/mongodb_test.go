@@ -16,6 +16,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/mongodb"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -180,3 +181,20 @@ func getExpected(t *testing.T) []*meteorv1beta1.Entity {
 		}),
 	}
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New:    func() plugins.Extractor { return mongodb.New(utils.Logger) },
+		Config: plugins.Config{URNScope: "test", RawConfig: map[string]any{"connection_url": "mongodb://localhost:27017"}},
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("mongodb://%s:%s@%s", user, pass, host),
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
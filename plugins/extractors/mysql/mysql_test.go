@@ -19,6 +19,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/mysql"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -173,3 +174,20 @@ func getExpected(t *testing.T) []*meteorv1beta1.Entity {
 		}),
 	}
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New:    func() plugins.Extractor { return mysql.New(utils.Logger) },
+		Config: plugins.Config{URNScope: "test", RawConfig: map[string]any{"connection_url": "admin:pass123@tcp(localhost:3306)/"}},
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("%s:%s@tcp(%s)/", user, pass, host),
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/raystack/meteor/plugins"
 	extractor "github.com/raystack/meteor/plugins/extractors/openapi"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -328,3 +329,17 @@ func initExtractor(t *testing.T, rawConfig map[string]any) *extractor.Extractor
 	require.NoError(t, err)
 	return extr
 }
+
+func TestConformance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"openapi": "3.0.0", "info": {"title": "Remote API", "version": "2.0.0"}, "paths": {}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	plugintest.ExtractorSuite{
+		New:       func() plugins.Extractor { return extractor.New(testutils.Logger) },
+		Config:    plugins.Config{URNScope: urnScope, RawConfig: map[string]any{"source": server.URL + "/openapi.json"}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/optimus"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
@@ -297,7 +298,7 @@ func (c *mockClient) GetJobTask(
 	return args.Get(0).(*pb.GetJobTaskResponse), args.Error(1)
 }
 
-func setupExtractExpectation(ctx context.Context, client *mockClient) {
+func setupExtractExpectation(ctx any, client *mockClient) {
 	client.On("Connect", ctx, validConfig["host"], 0).Return(nil).Once()
 
 	client.On("ListProjects", ctx, &pb.ListProjectsRequest{}, mock.Anything).Return(&pb.ListProjectsResponse{
@@ -649,3 +650,16 @@ func setupExtractExpectation(ctx context.Context, client *mockClient) {
 		},
 	}, nil).Once()
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor {
+			client := new(mockClient)
+			setupExtractExpectation(mock.Anything, client)
+			client.On("Close").Return(nil, nil)
+			return optimus.New(testutils.Logger, client)
+		},
+		Config:    plugins.Config{URNScope: urnScope, RawConfig: validConfig},
+		Reachable: true,
+	}.Run(t)
+}
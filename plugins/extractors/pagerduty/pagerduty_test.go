@@ -0,0 +1,21 @@
+//go:build plugins
+// +build plugins
+
+package pagerduty_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/extractors/pagerduty"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/test/utils"
+)
+
+// Extract is not checked: the extractor needs a live PagerDuty API and the
+// package has no test server for it.
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return pagerduty.New(utils.Logger) },
+	}.Run(t)
+}
@@ -20,6 +20,7 @@ import (
 	_ "github.com/raystack/meteor/plugins/extractors/kubernetes"
 	_ "github.com/raystack/meteor/plugins/extractors/mariadb"
 	_ "github.com/raystack/meteor/plugins/extractors/metabase"
+	_ "github.com/raystack/meteor/plugins/extractors/meteor"
 	_ "github.com/raystack/meteor/plugins/extractors/mongodb"
 	_ "github.com/raystack/meteor/plugins/extractors/mssql"
 	_ "github.com/raystack/meteor/plugins/extractors/mysql"
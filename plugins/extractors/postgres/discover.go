@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/sqlutil"
+)
+
+// Discover lists the databases of the server, leaving out templates, the
+// system databases and config.exclude.databases.
+func (e *Extractor) Discover(ctx context.Context, config plugins.Config) ([]plugins.Partition, error) {
+	var cfg Config
+	if err := plugins.DecodeConfig(config.RawConfig, &cfg); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(cfg.ConnectionURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse connection url: %w", err)
+	}
+
+	db, err := sql.Open("postgres", cfg.ConnectionURL)
+	if err != nil {
+		return nil, fmt.Errorf("create a client: %w", err)
+	}
+	defer db.Close()
+
+	dbs, err := sqlutil.FetchDBs(ctx, db, e.logger, "SELECT datname FROM pg_database WHERE datistemplate = false ORDER BY datname;")
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := sqlutil.BuildBoolMap(append(defaultDBList, cfg.Exclude.Databases...))
+	var partitions []plugins.Partition
+	for _, database := range dbs {
+		if excluded[database] {
+			continue
+		}
+		partitions = append(partitions, plugins.Partition{
+			Name: database,
+			Data: map[string]any{
+				"database": database,
+				"host":     u.Host,
+			},
+		})
+	}
+	return partitions, nil
+}
@@ -26,9 +26,16 @@ var defaultDBList = []string{"information_schema", "root", "postgres"}
 // Config holds the set of configuration options for the extractor
 type Config struct {
 	ConnectionURL string  `json:"connection_url" yaml:"connection_url" mapstructure:"connection_url" validate:"required"`
+	Include       Include `json:"include" yaml:"include" mapstructure:"include"`
 	Exclude       Exclude `json:"exclude" yaml:"exclude" mapstructure:"exclude"`
 }
 
+// Include contains the list of databases to extract. All databases are
+// extracted when it is empty.
+type Include struct {
+	Databases []string `json:"databases" yaml:"databases" mapstructure:"databases"`
+}
+
 // Exclude contains the list of databases to skip during extraction.
 type Exclude struct {
 	Databases []string `json:"databases" yaml:"databases" mapstructure:"databases"`
@@ -58,6 +65,7 @@ var info = plugins.Info{
 type Extractor struct {
 	plugins.BaseExtractor
 	excludedDbs map[string]bool
+	includedDbs map[string]bool
 	logger      log.Logger
 	config      Config
 	db          *sql.DB
@@ -89,6 +97,9 @@ func (e *Extractor) Init(ctx context.Context, config plugins.Config) (err error)
 	// build excluded database list
 	excludeList := append(defaultDBList, e.config.Exclude.Databases...)
 	e.excludedDbs = sqlutil.BuildBoolMap(excludeList)
+	if len(e.config.Include.Databases) > 0 {
+		e.includedDbs = sqlutil.BuildBoolMap(e.config.Include.Databases)
+	}
 
 	// Create database connection
 	e.db, err = sqlutil.OpenWithOtel("postgres", e.config.ConnectionURL, semconv.DBSystemPostgreSQL)
@@ -321,8 +332,12 @@ func (e *Extractor) extractConnectionComponents(connectionURL string) error {
 	return nil
 }
 
-// isExcludedDB checks if the given db is in the list of excluded databases
+// isExcludedDB checks if the given db is in the list of excluded databases,
+// or missing from the list of included ones
 func (e *Extractor) isExcludedDB(database string) bool {
+	if e.includedDbs != nil && !e.includedDbs[database] {
+		return true
+	}
 	_, ok := e.excludedDbs[database]
 	return ok
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/ory/dockertest/v3/docker"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/postgres"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -118,6 +119,48 @@ func TestExtract(t *testing.T) {
 	})
 }
 
+func TestExtractInclude(t *testing.T) {
+	utils.SkipIfNoDocker(t, dockerAvailable)
+	t.Run("should only extract included databases", func(t *testing.T) {
+		ctx := context.TODO()
+		extr := postgres.New(utils.Logger)
+
+		err := extr.Init(ctx, plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("postgres://%s:%s@%s/postgres?sslmode=disable", user, pass, host),
+				"include":        map[string]any{"databases": []string{"other_db"}},
+			}})
+		require.NoError(t, err)
+
+		emitter := mocks.NewEmitter()
+		err = extr.Extract(ctx, emitter.Push)
+		require.NoError(t, err)
+
+		assert.Empty(t, emitter.GetAllEntities())
+	})
+}
+
+func TestDiscover(t *testing.T) {
+	utils.SkipIfNoDocker(t, dockerAvailable)
+	t.Run("should return error for invalid config", func(t *testing.T) {
+		_, err := postgres.New(utils.Logger).Discover(context.TODO(), plugins.Config{})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should list the databases of the server", func(t *testing.T) {
+		partitions, err := postgres.New(utils.Logger).Discover(context.TODO(), plugins.Config{
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("postgres://%s:%s@%s/postgres?sslmode=disable", user, pass, host),
+			}})
+		require.NoError(t, err)
+
+		assert.Equal(t, []plugins.Partition{
+			{Name: "test_db", Data: map[string]any{"database": "test_db", "host": host}},
+		}, partitions)
+	})
+}
+
 func setup() (err error) {
 	testDB := "test_db"
 
@@ -183,3 +226,19 @@ func getExpected(t *testing.T) []*meteorv1beta1.Entity {
 		}),
 	}
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return postgres.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url": fmt.Sprintf("postgres://%s:%s@%s/postgres?sslmode=disable", user, pass, host),
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -16,6 +16,7 @@ import (
 	_ "github.com/prestodb/presto-go-client/presto"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/presto"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -121,3 +122,20 @@ func TestExtract(t *testing.T) {
 		assert.GreaterOrEqual(t, len(urns), 30)
 	})
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return presto.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"connection_url":  fmt.Sprintf("http://%s@%s", user, host),
+				"exclude_catalog": "memory,jmx,tpcds,tpch",
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -14,6 +14,7 @@ import (
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/redash"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	testUtils "github.com/raystack/meteor/test/utils"
@@ -187,3 +188,17 @@ func NewTestServer() *httptest.Server {
 	})
 	return httptest.NewServer(mux)
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return redash.New(utils.Logger) },
+		Config: plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"base_url": testServer.URL,
+				"api_key":  "checkAPI",
+			},
+		},
+		Reachable: true,
+	}.Run(t)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice/redshiftdataapiserviceiface"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/redshift"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -362,3 +363,35 @@ func TestExtract(t *testing.T) {
 		utils.AssertProtosWithJSONFile(t, "testdata/expected-assets.json", actual)
 	})
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor {
+			return redshift.New(utils.Logger, redshift.WithClient(&mockRedshiftDataAPIClient{
+				ListDatabasesOutput: redshiftdataapiservice.ListDatabasesOutput{
+					Databases: []*string{aws.String("dev")},
+				},
+				ListTablesOutput: redshiftdataapiservice.ListTablesOutput{
+					Tables: []*redshiftdataapiservice.TableMember{
+						{Name: aws.String("sql_features"), Schema: aws.String("information_schema"), Type: aws.String("SYSTEM TABLE")},
+					},
+				},
+				DescribeTableOutput: redshiftdataapiservice.DescribeTableOutput{
+					ColumnList: []*redshiftdataapiservice.ColumnMetadata{
+						{Name: aws.String("column_name"), TypeName: aws.String("character_data")},
+					},
+				},
+			}))
+		},
+		Config: plugins.Config{
+			URNScope: "test-redshift",
+			RawConfig: map[string]any{
+				"cluster_id": "some-cluster-id",
+				"db_name":    "some-db-name",
+				"db_user":    "some-user",
+				"aws_region": "google-project-id",
+			},
+		},
+		Reachable: true,
+	}.Run(t)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/dnaeon/go-vcr/v2/recorder"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/snowflake"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/raystack/meteor/test/utils"
 	_ "github.com/snowflakedb/gosnowflake" // used to register the snowflake driver
@@ -89,3 +90,11 @@ func TestExtract(t *testing.T) {
 		assert.Equal(t, 86, len(urns))
 	})
 }
+
+// Extract is not checked: the only offline source is the recorded sample
+// data cassette, and replaying it takes about two minutes per run.
+func TestConformance(t *testing.T) {
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return snowflake.New(utils.Logger) },
+	}.Run(t)
+}
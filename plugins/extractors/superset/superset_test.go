@@ -21,6 +21,7 @@ import (
 	"github.com/ory/dockertest/v3/docker"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/superset"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -265,3 +266,22 @@ func makeRequest(method, url string, payload any, data any) (err error) {
 	}
 	return
 }
+
+func TestConformance(t *testing.T) {
+	suite := plugintest.ExtractorSuite{
+		New: func() plugins.Extractor { return superset.New(utils.Logger) },
+	}
+	if dockerAvailable {
+		suite.Config = plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"username": user,
+				"password": pass,
+				"host":     host,
+				"provider": provider,
+			},
+		}
+		suite.Reachable = true
+	}
+	suite.Run(t)
+}
@@ -7,13 +7,16 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/dnaeon/go-vcr/v2/recorder"
 	"github.com/raystack/meteor/plugins"
 	"github.com/raystack/meteor/plugins/extractors/tableau"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/test/mocks"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -110,3 +113,30 @@ func TestExtract(t *testing.T) {
 		testutils.AssertProtosWithJSONFile(t, "./testdata/dashboards_proto.json", actuals)
 	})
 }
+
+func TestConformance(t *testing.T) {
+	// Every extractor signs in again, so each one replays the recording
+	// from its start.
+	const cassette = "fixtures/get_workbooks_graphql_e2e"
+	require.FileExists(t, cassette+".yaml")
+
+	plugintest.ExtractorSuite{
+		New: func() plugins.Extractor {
+			r, _ := recorder.New(cassette)
+			return tableau.New(testutils.Logger, tableau.WithHTTPClient(&http.Client{Transport: r}))
+		},
+		Config: plugins.Config{
+			URNScope: urnScope,
+			RawConfig: map[string]any{
+				"host":       host,
+				"version":    version,
+				"identifier": "my-tableau",
+				"sitename":   sitename,
+				"username":   username,
+				"password":   password,
+			},
+		},
+		Reachable: true,
+		Timeout:   30 * time.Second,
+	}.Run(t)
+}
@@ -0,0 +1,199 @@
+// Package httprecorder records the HTTP interactions of a run into a
+// cassette and replays them later, so that API responses behind a bug
+// report can be shared without sharing credentials.
+package httprecorder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dnaeon/go-vcr/v2/cassette"
+)
+
+// Mode is the operating mode of a Recorder.
+type Mode int
+
+const (
+	// ModeRecord performs real requests and stores them in the cassette.
+	ModeRecord Mode = iota
+	// ModeReplay serves responses from the cassette without touching the network.
+	ModeReplay
+)
+
+// cassetteName is the file name, without extension, of the cassette inside the directory.
+const cassetteName = "http"
+
+// ErrInteractionNotFound is returned in replay mode for requests missing from the cassette.
+var ErrInteractionNotFound = errors.New("http interaction not found in cassette")
+
+// Recorder records or replays the HTTP interactions of every transport it wraps.
+// All wrapped transports share a single cassette.
+type Recorder struct {
+	mode     Mode
+	cassette *cassette.Cassette
+}
+
+// New returns a Recorder storing its cassette in dir. In replay mode the
+// cassette must already exist.
+func New(dir string, mode Mode) (*Recorder, error) {
+	name := filepath.Join(dir, cassetteName)
+
+	var (
+		c   *cassette.Cassette
+		err error
+	)
+	switch mode {
+	case ModeRecord:
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create cassette dir: %w", err)
+		}
+		c = cassette.New(name)
+	case ModeReplay:
+		c, err = cassette.Load(name)
+		if err != nil {
+			return nil, fmt.Errorf("load cassette: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown recorder mode %d", mode)
+	}
+
+	c.Filters = append(c.Filters, redactInteraction)
+	c.Matcher = matchRequest
+
+	return &Recorder{mode: mode, cassette: c}, nil
+}
+
+// Path returns the location of the cassette file.
+func (r *Recorder) Path() string {
+	return r.cassette.File
+}
+
+// Wrap returns a transport that records or replays requests made through base.
+func (r *Recorder) Wrap(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{recorder: r, base: base}
+}
+
+// Stop saves the cassette in record mode. It is a no-op in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	return r.cassette.Save()
+}
+
+type transport struct {
+	recorder *Recorder
+	base     http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.recorder.mode == ModeReplay {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+func (t *transport) replay(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	i, err := t.recorder.cassette.GetInteraction(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, redactURL(req.URL.String()))
+	}
+
+	return &http.Response{
+		Status:        i.Response.Status,
+		StatusCode:    i.Response.Code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        i.Response.Headers.Clone(),
+		Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+		ContentLength: int64(len(i.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *transport) record(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	duration := time.Since(start)
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body:    string(reqBody),
+			Headers: req.Header.Clone(),
+			URL:     req.URL.String(),
+			Method:  req.Method,
+		},
+		Response: cassette.Response{
+			Body:     string(respBody),
+			Headers:  resp.Header.Clone(),
+			Status:   resp.Status,
+			Code:     resp.StatusCode,
+			Duration: duration,
+		},
+	}
+	for _, filter := range t.recorder.cassette.Filters {
+		if err := filter(i); err != nil {
+			return nil, err
+		}
+	}
+	t.recorder.cassette.AddInteraction(i)
+
+	return resp, nil
+}
+
+// matchRequest compares the method, the redacted URL and the redacted body,
+// so that requests carrying live credentials match their recorded counterpart.
+func matchRequest(req *http.Request, recorded cassette.Request) bool {
+	if req.Method != recorded.Method || redactURL(req.URL.String()) != recorded.URL {
+		return false
+	}
+
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return false
+	}
+	return redactBody(string(body)) == recorded.Body
+}
+
+// readBody reads the body and replaces it with an equivalent reader.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
@@ -0,0 +1,100 @@
+package httprecorder_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/raystack/meteor/plugins/httprecorder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	// Keys out of order and an ID beyond float64 precision must be replayed
+	// as recorded.
+	const dashboards = `{"dashboards":[{"name":"orders","author":"alice","id":9007199254740993}]}`
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Set-Cookie", "session=abc123")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/signin":
+			_, _ = io.WriteString(w, `{"credentials":{"token":"live-token","site":{"id":"site-1"}}}`)
+		default:
+			_, _ = io.WriteString(w, dashboards)
+		}
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	call := func(t *testing.T, cl *http.Client) (string, string) {
+		t.Helper()
+
+		signin, err := http.NewRequest(http.MethodPost, srv.URL+"/signin", strings.NewReader(`{"password":"hunter2","name":"bob"}`))
+		require.NoError(t, err)
+		resp, err := cl.Do(signin)
+		require.NoError(t, err)
+		b1, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		list, err := http.NewRequest(http.MethodGet, srv.URL+"/dashboards?api_key=k-123&page=1", nil)
+		require.NoError(t, err)
+		list.Header.Set("Authorization", "Bearer live-token")
+		resp, err = cl.Do(list)
+		require.NoError(t, err)
+		b2, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		return string(b1), string(b2)
+	}
+
+	t.Run("should record interactions with secrets redacted", func(t *testing.T) {
+		rec, err := httprecorder.New(dir, httprecorder.ModeRecord)
+		require.NoError(t, err)
+
+		signin, list := call(t, &http.Client{Transport: rec.Wrap(nil)})
+		assert.Contains(t, signin, "live-token", "live responses must not be altered")
+		assert.Contains(t, list, "orders")
+		require.NoError(t, rec.Stop())
+
+		b, err := os.ReadFile(filepath.Join(dir, "http.yaml"))
+		require.NoError(t, err)
+		cassette := string(b)
+		for _, secret := range []string{"hunter2", "live-token", "k-123", "abc123"} {
+			assert.NotContains(t, cassette, secret)
+		}
+		assert.Contains(t, cassette, "[REDACTED]")
+		assert.Contains(t, cassette, "alice", "non-sensitive fields must be kept")
+		assert.Equal(t, 2, hits)
+	})
+
+	t.Run("should replay interactions without network", func(t *testing.T) {
+		rec, err := httprecorder.New(dir, httprecorder.ModeReplay)
+		require.NoError(t, err)
+
+		signin, list := call(t, &http.Client{Transport: rec.Wrap(nil)})
+		assert.Contains(t, signin, "site-1")
+		assert.Equal(t, dashboards, list)
+		assert.Equal(t, 2, hits)
+	})
+
+	t.Run("should fail for requests missing from cassette", func(t *testing.T) {
+		rec, err := httprecorder.New(dir, httprecorder.ModeReplay)
+		require.NoError(t, err)
+
+		cl := &http.Client{Transport: rec.Wrap(nil)}
+		_, err = cl.Get(srv.URL + "/unknown")
+		assert.ErrorIs(t, err, httprecorder.ErrInteractionNotFound)
+	})
+
+	t.Run("should return error when replaying a missing cassette", func(t *testing.T) {
+		_, err := httprecorder.New(t.TempDir(), httprecorder.ModeReplay)
+		assert.Error(t, err)
+	})
+}
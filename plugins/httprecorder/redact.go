@@ -0,0 +1,133 @@
+package httprecorder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/dnaeon/go-vcr/v2/cassette"
+)
+
+// redacted replaces every secret value stored in a cassette.
+const redacted = "[REDACTED]"
+
+// sensitiveKey matches header, query parameter and JSON field names that carry credentials.
+var sensitiveKey = regexp.MustCompile(`(?i)(^auth$|authorization|[-_]auth$|token|secret|password|passwd|api[-_]?key|cookie|session|credential|signature|private[-_]?key)`)
+
+// bearerToken matches tokens embedded in free text, e.g. in error messages.
+var bearerToken = regexp.MustCompile(`(?i)(bearer|basic|token)\s+[A-Za-z0-9\-._~+/]+=*`)
+
+func redactInteraction(i *cassette.Interaction) error {
+	i.Request.URL = redactURL(i.Request.URL)
+	i.Request.Headers = redactHeaders(i.Request.Headers)
+	i.Request.Body = redactBody(i.Request.Body)
+	i.Response.Headers = redactHeaders(i.Response.Headers)
+	i.Response.Body = redactBody(i.Response.Body)
+	return nil
+}
+
+func redactHeaders(h http.Header) http.Header {
+	out := make(http.Header, len(h))
+	for k, vv := range h {
+		if sensitiveKey.MatchString(k) {
+			out[k] = []string{redacted}
+			continue
+		}
+		out[k] = vv
+	}
+	return out
+}
+
+// redactURL removes userinfo passwords and masks sensitive query parameters.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+
+	if u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redacted)
+		}
+	}
+
+	q := u.Query()
+	changed := false
+	for k := range q {
+		if sensitiveKey.MatchString(k) {
+			q[k] = []string{redacted}
+			changed = true
+		}
+	}
+	if changed {
+		u.RawQuery = q.Encode()
+	}
+
+	return u.String()
+}
+
+// redactBody masks sensitive fields in JSON and form bodies, and bearer
+// tokens in anything else.
+func redactBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	trimmed := strings.TrimSpace(body)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		// Numbers are kept as written, and bodies without secrets are kept
+		// as they are, so replayed responses match the recorded ones.
+		dec := json.NewDecoder(strings.NewReader(trimmed))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err == nil && !dec.More() {
+			if !redactJSON(v) {
+				return body
+			}
+			if b, err := json.Marshal(v); err == nil {
+				return string(b)
+			}
+		}
+	}
+
+	if form, err := url.ParseQuery(body); err == nil && strings.Contains(body, "=") && !strings.ContainsAny(body, " \n") {
+		changed := false
+		for k := range form {
+			if sensitiveKey.MatchString(k) {
+				form[k] = []string{redacted}
+				changed = true
+			}
+		}
+		if changed {
+			return form.Encode()
+		}
+	}
+
+	return bearerToken.ReplaceAllString(body, "${1} "+redacted)
+}
+
+// redactJSON masks string values of sensitive keys in place, recursing into
+// objects and arrays, and reports whether it masked any.
+func redactJSON(v any) bool {
+	changed := false
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if s, isString := child.(string); isString && sensitiveKey.MatchString(k) {
+				if s != redacted {
+					val[k] = redacted
+					changed = true
+				}
+				continue
+			}
+			changed = redactJSON(child) || changed
+		}
+	case []any:
+		for _, child := range val {
+			changed = redactJSON(child) || changed
+		}
+	}
+	return changed
+}
@@ -0,0 +1,236 @@
+package tmplutil
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+)
+
+// Value is a config value whose strings, at any depth of its lists and maps,
+// may be Go templates evaluated against each entity. Numbers, bools and
+// other strings are kept as they are.
+type Value struct {
+	literal  any
+	template *template.Template
+	list     []*Value
+	fields   map[string]*Value
+}
+
+// Parse parses the templates in v, naming them after name and the path to
+// them.
+func Parse(name string, v any) (*Value, error) {
+	switch v := v.(type) {
+	case string:
+		if !strings.Contains(v, "{{") {
+			return &Value{literal: v}, nil
+		}
+		tmpl, err := template.New(name).Funcs(Funcs()).Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("parse template %q: %w", name, err)
+		}
+		return &Value{template: tmpl}, nil
+
+	case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return &Value{literal: v}, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		list := make([]*Value, rv.Len())
+		for i := range list {
+			item, err := Parse(fmt.Sprintf("%s[%d]", name, i), rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			list[i] = item
+		}
+		return &Value{list: list}, nil
+
+	case reflect.Map:
+		fields := make(map[string]*Value, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			field, err := Parse(name+"."+key, iter.Value().Interface())
+			if err != nil {
+				return nil, err
+			}
+			fields[key] = field
+		}
+		return &Value{fields: fields}, nil
+	}
+
+	return nil, fmt.Errorf("%s: unsupported value of type %T", name, v)
+}
+
+// Render evaluates the templates of the value against data. Templates
+// rendering to an empty string are left out of lists and maps, and reported
+// with ok false at the top level.
+func (v *Value) Render(data map[string]any) (value any, ok bool, err error) {
+	switch {
+	case v.template != nil:
+		var buf bytes.Buffer
+		if err := v.template.Execute(&buf, data); err != nil {
+			return nil, false, err
+		}
+		// Missing keys print as <no value>, and are left empty instead.
+		s := strings.TrimSpace(strings.ReplaceAll(buf.String(), "<no value>", ""))
+		return s, s != "", nil
+
+	case v.list != nil:
+		list := make([]any, 0, len(v.list))
+		for _, item := range v.list {
+			rendered, ok, err := item.Render(data)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				list = append(list, rendered)
+			}
+		}
+		return list, true, nil
+
+	case v.fields != nil:
+		fields := make(map[string]any, len(v.fields))
+		for key, field := range v.fields {
+			rendered, ok, err := field.Render(data)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				fields[key] = rendered
+			}
+		}
+		return fields, true, nil
+	}
+
+	return v.literal, true, nil
+}
+
+// Data exposes the entity, and the parts of its URN, to the templates.
+func Data(entity *meteorv1beta1.Entity) map[string]any {
+	data := map[string]any{
+		"urn":         entity.GetUrn(),
+		"type":        entity.GetType(),
+		"name":        entity.GetName(),
+		"description": entity.GetDescription(),
+		"source":      entity.GetSource(),
+		"properties":  entity.GetProperties().AsMap(),
+	}
+	if service, scope, _, id, ok := SplitURN(entity.GetUrn()); ok {
+		data["service"], data["scope"], data["id"] = service, scope, id
+	}
+	return data
+}
+
+// SplitURN returns the parts of urn:service:scope:type:id. ok is false when
+// urn is not in that form.
+func SplitURN(urn string) (service, scope, typ, id string, ok bool) {
+	parts := strings.SplitN(urn, ":", 5)
+	if len(parts) < 5 || parts[0] != "urn" {
+		return "", "", "", "", false
+	}
+	return parts[1], parts[2], parts[3], parts[4], true
+}
+
+// Field returns the value at a dotted path of a JSON object.
+func Field(v any, path string) any {
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
+// Funcs returns the functions available to the templates. Like pipelines,
+// the value they work on comes last, e.g. {{ .urn | regexFind "prod|stg" }}.
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		"default":   defaultValue,
+		"lower":     strings.ToLower,
+		"upper":     strings.ToUpper,
+		"trim":      strings.TrimSpace,
+		"replace":   func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		"contains":  func(substr, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix": func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix": func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+		"split":     func(sep, s string) []string { return strings.Split(s, sep) },
+		"join":      join,
+		"keys":      keys,
+		"regexFind": func(pattern, s string) (string, error) {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return "", err
+			}
+			return re.FindString(s), nil
+		},
+		"regexReplace": func(pattern, repl, s string) (string, error) {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return "", err
+			}
+			return re.ReplaceAllString(s, repl), nil
+		},
+	}
+}
+
+// defaultValue returns def when v is empty, e.g.
+// {{ .properties.labels.owner | default "unknown" }}.
+func defaultValue(def, v any) any {
+	if v == nil {
+		return def
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if rv.Len() == 0 {
+			return def
+		}
+	default:
+		if rv.IsZero() {
+			return def
+		}
+	}
+	return v
+}
+
+func join(sep string, v any) (string, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return "", fmt.Errorf("join: expected a list, got %T", v)
+	}
+
+	parts := make([]string, rv.Len())
+	for i := range parts {
+		parts[i] = fmt.Sprint(rv.Index(i).Interface())
+	}
+	return strings.Join(parts, sep), nil
+}
+
+// keys returns the sorted keys of a map, e.g. {{ .properties.labels | keys | join "," }}.
+func keys(v any) ([]string, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		if v == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("keys: expected a map, got %T", v)
+	}
+
+	list := make([]string, 0, len(m))
+	for k := range m {
+		list = append(list, k)
+	}
+	sort.Strings(list)
+	return list, nil
+}
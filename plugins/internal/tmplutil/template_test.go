@@ -0,0 +1,92 @@
+//go:build plugins
+
+package tmplutil_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValue(t *testing.T) {
+	data := tmplutil.Data(models.NewEntity("urn:bigquery:prod:table:shop.orders", "table", "orders", "bigquery", map[string]any{
+		"labels":  map[string]any{"owner": "sales", "tier": "gold"},
+		"columns": []any{map[string]any{"name": "id"}},
+	}))
+
+	cases := []struct {
+		name     string
+		value    any
+		expected any
+		ok       bool
+	}{
+		{name: "literal string", value: "data", expected: "data", ok: true},
+		{name: "number", value: 42, expected: 42, ok: true},
+		{name: "bool", value: true, expected: true, ok: true},
+		{name: "urn parts", value: "{{ .service }}/{{ .scope }}/{{ .id }}", expected: "bigquery/prod/shop.orders", ok: true},
+		{name: "property", value: "{{ .properties.labels.owner }}", expected: "sales", ok: true},
+		{name: "default", value: `{{ .properties.labels.team | default "unknown" }}`, expected: "unknown", ok: true},
+		{name: "missing", value: "{{ .properties.labels.team }}", ok: false},
+		{name: "regexFind", value: `{{ regexFind "prod|stg" .urn }}`, expected: "prod", ok: true},
+		{name: "regexReplace", value: `{{ .name | regexReplace "s$" "" }}`, expected: "order", ok: true},
+		{name: "keys", value: `{{ .properties.labels | keys | join "," }}`, expected: "owner,tier", ok: true},
+		{
+			name:     "list and map",
+			value:    map[string]any{"owner": "{{ .properties.labels.owner }}", "tags": []any{"{{ .type }}", "{{ .properties.missing }}", 1}},
+			expected: map[string]any{"owner": "sales", "tags": []any{"table", 1}},
+			ok:       true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := tmplutil.Parse(tc.name, tc.value)
+			require.NoError(t, err)
+
+			actual, ok, err := v.Render(data)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("should return error for invalid template", func(t *testing.T) {
+		_, err := tmplutil.Parse("team", map[string]any{"names": []any{"{{ .name"}})
+		assert.ErrorContains(t, err, `parse template "team.names[0]"`)
+	})
+
+	t.Run("should return error for unsupported value", func(t *testing.T) {
+		_, err := tmplutil.Parse("team", struct{}{})
+		assert.ErrorContains(t, err, "unsupported value")
+	})
+}
+
+func TestSplitURN(t *testing.T) {
+	t.Run("should return the parts of the urn", func(t *testing.T) {
+		service, scope, typ, id, ok := tmplutil.SplitURN("urn:bigquery:prod:table:shop:orders")
+		assert.True(t, ok)
+		assert.Equal(t, []string{"bigquery", "prod", "table", "shop:orders"}, []string{service, scope, typ, id})
+	})
+
+	t.Run("should return false for other urns", func(t *testing.T) {
+		for _, urn := range []string{"urn:user:jane", "bigquery:prod:table:shop:orders", ""} {
+			_, _, _, _, ok := tmplutil.SplitURN(urn)
+			assert.False(t, ok, urn)
+		}
+	})
+}
+
+func TestField(t *testing.T) {
+	v := map[string]any{"data": map[string]any{"owner": map[string]any{"urn": "urn:user:jane"}}, "tier": "gold"}
+
+	assert.Equal(t, "gold", tmplutil.Field(v, "tier"))
+	assert.Equal(t, "urn:user:jane", tmplutil.Field(v, "data.owner.urn"))
+	assert.Nil(t, tmplutil.Field(v, "data.team"))
+	assert.Nil(t, tmplutil.Field(v, "tier.name"))
+}
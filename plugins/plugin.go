@@ -70,6 +70,23 @@ type Processor interface {
 	Process(ctx context.Context, src models.Record) (dst models.Record, err error)
 }
 
+// RecordEmitter is a processor that emits records of its own, such as change
+// events, besides the records it processes. Processors that implement
+// io.Closer are closed once the run is done.
+type RecordEmitter interface {
+	// SetEmit is called before Init with the function that sends a record
+	// straight to the sinks, skipping the processors.
+	SetEmit(emit Emit)
+}
+
+// Committer is a processor keeping state between runs, such as the columns
+// seen in a run. Commit is called once a run that is not a dry run has
+// succeeded, so that runs whose records did not reach the sinks leave the
+// state as it was.
+type Committer interface {
+	Commit() error
+}
+
 // Syncer is a plugin that can be used to sync data from one source to another.
 type Syncer interface {
 	Plugin
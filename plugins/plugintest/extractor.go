@@ -0,0 +1,132 @@
+package plugintest
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ExtractorSuite checks the contract of an extractor.
+type ExtractorSuite struct {
+	// New returns a fresh, uninitialised extractor. It is called once per check.
+	New func() plugins.Extractor
+	// Config is a valid configuration for the extractor. It defaults to the
+	// sample config from Info.
+	Config plugins.Config
+	// EmptyConfigValid is set for extractors without required config keys.
+	EmptyConfigValid bool
+	// NoEntities is set for extractors that emit whatever entity types
+	// their input holds and therefore cannot declare them in Info.
+	NoEntities bool
+	// Reachable is set when Config points at a source available to the
+	// test. It enables the checks that call Extract.
+	Reachable bool
+	// Timeout overrides DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Run runs the suite as subtests of t.
+func (s ExtractorSuite) Run(t *testing.T) {
+	t.Helper()
+	require.NotNil(t, s.New, "ExtractorSuite.New")
+	if s.Config.RawConfig == nil {
+		s.Config = sampleConfig(t, s.New())
+	}
+
+	c := common{config: s.Config, emptyConfigValid: s.EmptyConfigValid, timeout: s.Timeout}
+	newFn := func() plugins.Plugin { return s.New() }
+
+	info := c.runInfo(t, s.New())
+	if !s.NoEntities {
+		t.Run("Info has entities declared", func(t *testing.T) {
+			assertEntitiesDeclared(t, info)
+		})
+	}
+	c.runValidate(t, newFn)
+	c.runInit(t, newFn)
+
+	if !s.Reachable {
+		return
+	}
+
+	t.Run("Extract emits valid records", func(t *testing.T) {
+		ctx := context.Background()
+		extr := s.New()
+		require.NoError(t, extr.Init(ctx, s.Config))
+
+		var (
+			mu      sync.Mutex
+			records []models.Record
+		)
+		err := c.within(t, "Extract", func() error {
+			return extr.Extract(ctx, func(rec models.Record) {
+				mu.Lock()
+				records = append(records, rec)
+				mu.Unlock()
+			})
+		})
+		require.NoError(t, err)
+
+		declared := make([]string, 0, len(info.Entities))
+		for _, e := range info.Entities {
+			declared = append(declared, e.Type)
+		}
+		for _, rec := range records {
+			assertValidRecord(t, rec)
+			if typ := rec.Entity().GetType(); !s.NoEntities && !slices.Contains(declared, typ) {
+				assert.Failf(t, "undeclared entity type", "entity %q has type %q, Info declares %v", rec.Entity().GetUrn(), typ, declared)
+			}
+		}
+	})
+
+	t.Run("Extract returns on ctx.Done", func(t *testing.T) {
+		extr := s.New()
+		require.NoError(t, extr.Init(context.Background(), s.Config))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		// Extract may report the cancellation or return early without error,
+		// as long as it returns.
+		_ = c.within(t, "Extract", func() error {
+			return extr.Extract(ctx, func(models.Record) {})
+		})
+	})
+}
+
+func assertEntitiesDeclared(t *testing.T, info plugins.Info) {
+	t.Helper()
+
+	assert.NotEmpty(t, info.Entities, "Info().Entities")
+	for _, e := range info.Entities {
+		assert.NotEmpty(t, e.Type, "entity type")
+		assert.NotEmpty(t, e.URNPattern, "URN pattern of entity %q", e.Type)
+	}
+	for _, e := range info.Edges {
+		assert.NotEmpty(t, e.Type, "edge type")
+		assert.NotEmpty(t, e.From, "source entity type of edge %q", e.Type)
+		assert.NotEmpty(t, e.To, "target entity type of edge %q", e.Type)
+	}
+}
+
+func assertValidRecord(t *testing.T, rec models.Record) {
+	t.Helper()
+
+	entity := rec.Entity()
+	if !assert.NotNil(t, entity, "record entity") {
+		return
+	}
+	assert.NotEmpty(t, entity.GetUrn(), "entity URN")
+	assert.NotEmpty(t, entity.GetType(), "type of entity %q", entity.GetUrn())
+	for _, e := range rec.Edges() {
+		assert.NotEmpty(t, e.GetSourceUrn(), "source URN of %q edge of %q", e.GetType(), entity.GetUrn())
+		assert.NotEmpty(t, e.GetTargetUrn(), "target URN of %q edge of %q", e.GetType(), entity.GetUrn())
+		assert.NotEmpty(t, e.GetType(), "type of edge of %q", entity.GetUrn())
+	}
+}
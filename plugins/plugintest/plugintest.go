@@ -0,0 +1,116 @@
+// Package plugintest provides conformance suites that check the contract
+// shared by every extractor, processor and sink, so that plugin tests only
+// need to cover plugin specific behaviour:
+//
+//	func TestConformance(t *testing.T) {
+//		plugintest.ExtractorSuite{
+//			New:       func() plugins.Extractor { return csv.New(utils.Logger) },
+//			Config:    plugins.Config{URNScope: "test", RawConfig: map[string]any{"path": "./testdata"}},
+//			Reachable: true,
+//		}.Run(t)
+//	}
+//
+// Checks that call Extract or Sink only run when the suite is marked
+// Reachable, i.e. when Config points at a source or destination that is
+// available to the test, such as a testdata directory, an httptest server
+// or a docker container.
+package plugintest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultTimeout bounds the time a plugin may take to return once its
+// context is cancelled.
+const DefaultTimeout = 10 * time.Second
+
+// sampleConfig parses the sample config of the plugin, which every
+// plugin is expected to keep valid, and uses it when a suite has no Config.
+func sampleConfig(t *testing.T, p plugins.Plugin) plugins.Config {
+	t.Helper()
+
+	raw := map[string]any{}
+	require.NoError(t, yaml.Unmarshal([]byte(p.Info().SampleConfig), &raw), "parse Info().SampleConfig")
+	return plugins.Config{URNScope: "test", RawConfig: raw}
+}
+
+// common holds the settings shared by every suite.
+type common struct {
+	config           plugins.Config
+	emptyConfigValid bool
+	timeout          time.Duration
+}
+
+func (c common) runInfo(t *testing.T, p plugins.Plugin) plugins.Info {
+	t.Helper()
+
+	info := p.Info()
+	t.Run("Info has a description", func(t *testing.T) {
+		assert.NotEmpty(t, info.Description, "Info().Description")
+	})
+	return info
+}
+
+func (c common) runValidate(t *testing.T, newFn func() plugins.Plugin) {
+	t.Helper()
+
+	empty := plugins.Config{URNScope: c.config.URNScope, RawConfig: map[string]any{}}
+	if c.emptyConfigValid {
+		t.Run("Validate accepts empty config", func(t *testing.T) {
+			assert.NoError(t, newFn().Validate(empty))
+		})
+	} else {
+		t.Run("Validate rejects empty config", func(t *testing.T) {
+			err := newFn().Validate(empty)
+			assert.ErrorAs(t, err, &plugins.InvalidConfigError{}, "Validate() with empty config should return plugins.InvalidConfigError")
+		})
+	}
+
+	t.Run("Validate accepts config", func(t *testing.T) {
+		assert.NoError(t, newFn().Validate(c.config))
+	})
+}
+
+func (c common) runInit(t *testing.T, newFn func() plugins.Plugin) {
+	t.Helper()
+
+	t.Run("Init respects context cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := c.within(t, "Init", func() error {
+			return newFn().Init(ctx, c.config)
+		})
+		assert.Error(t, err, "Init() with a cancelled context should fail")
+	})
+}
+
+// within calls fn and fails the test if it does not return before the suite timeout.
+func (c common) within(t *testing.T, name string, fn func() error) error {
+	t.Helper()
+
+	timeout := c.timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		require.FailNowf(t, "timeout", "%s() did not return within %s", name, timeout)
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package plugintest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ProcessorSuite checks the contract of a processor.
+type ProcessorSuite struct {
+	// New returns a fresh, uninitialised processor. It is called once per check.
+	New func() plugins.Processor
+	// Config is a valid configuration for the processor. It defaults to the
+	// sample config from Info.
+	Config plugins.Config
+	// EmptyConfigValid is set for processors without required config keys.
+	EmptyConfigValid bool
+	// Record is processed by the Process check. It defaults to a bare table entity.
+	Record models.Record
+	// Reachable is set when the processor can run with Config in the test,
+	// e.g. when the services it calls are available. It enables the checks
+	// that call Process.
+	Reachable bool
+	// Timeout overrides DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Run runs the suite as subtests of t.
+func (s ProcessorSuite) Run(t *testing.T) {
+	t.Helper()
+	require.NotNil(t, s.New, "ProcessorSuite.New")
+	if s.Config.RawConfig == nil {
+		s.Config = sampleConfig(t, s.New())
+	}
+
+	c := common{config: s.Config, emptyConfigValid: s.EmptyConfigValid, timeout: s.Timeout}
+	newFn := func() plugins.Plugin { return s.New() }
+
+	c.runInfo(t, s.New())
+	c.runValidate(t, newFn)
+	c.runInit(t, newFn)
+
+	if !s.Reachable {
+		return
+	}
+
+	t.Run("Process keeps the entity URN", func(t *testing.T) {
+		ctx := context.Background()
+		proc := s.New()
+		require.NoError(t, proc.Init(ctx, s.Config))
+
+		src := s.Record
+		if src.Entity() == nil {
+			src = models.NewRecord(models.NewEntity("urn:plugintest:test:table:sample", "table", "sample", "plugintest", nil))
+		}
+		urn := src.Entity().GetUrn()
+
+		var dst models.Record
+		err := c.within(t, "Process", func() (err error) {
+			dst, err = proc.Process(ctx, src)
+			return err
+		})
+		require.NoError(t, err)
+		assertValidRecord(t, dst)
+		assert.Equal(t, urn, dst.Entity().GetUrn())
+	})
+}
@@ -0,0 +1,70 @@
+package plugintest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// SinkSuite checks the contract of a sink.
+type SinkSuite struct {
+	// New returns a fresh, uninitialised sink. It is called once per check.
+	New func() plugins.Syncer
+	// Config is a valid configuration for the sink. It defaults to the
+	// sample config from Info.
+	Config plugins.Config
+	// EmptyConfigValid is set for sinks without required config keys.
+	EmptyConfigValid bool
+	// Reachable is set when Config points at a destination available to
+	// the test. It enables the checks that call Sink and Close.
+	Reachable bool
+	// Timeout overrides DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Run runs the suite as subtests of t.
+func (s SinkSuite) Run(t *testing.T) {
+	t.Helper()
+	require.NotNil(t, s.New, "SinkSuite.New")
+	if s.Config.RawConfig == nil {
+		s.Config = sampleConfig(t, s.New())
+	}
+
+	c := common{config: s.Config, emptyConfigValid: s.EmptyConfigValid, timeout: s.Timeout}
+	newFn := func() plugins.Plugin { return s.New() }
+
+	c.runInfo(t, s.New())
+	c.runValidate(t, newFn)
+	c.runInit(t, newFn)
+
+	if !s.Reachable {
+		return
+	}
+
+	t.Run("Sink handles empty batches", func(t *testing.T) {
+		ctx := context.Background()
+		sink := s.New()
+		require.NoError(t, sink.Init(ctx, s.Config))
+		defer sink.Close()
+
+		assert.NoError(t, c.within(t, "Sink", func() error {
+			return sink.Sink(ctx, nil)
+		}), "Sink() with a nil batch")
+		assert.NoError(t, c.within(t, "Sink", func() error {
+			return sink.Sink(ctx, []models.Record{})
+		}), "Sink() with an empty batch")
+	})
+
+	t.Run("Close is idempotent", func(t *testing.T) {
+		sink := s.New()
+		require.NoError(t, sink.Init(context.Background(), s.Config))
+
+		assert.NoError(t, sink.Close(), "first Close()")
+		assert.NoError(t, sink.Close(), "second Close()")
+	})
+}
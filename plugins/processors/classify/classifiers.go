@@ -0,0 +1,166 @@
+package classify
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// sensitivity levels, from the least to the most sensitive.
+var levels = map[string]int{"low": 1, "medium": 2, "high": 3}
+
+// classifier tags columns by their name or the values in their preview.
+type classifier struct {
+	name        string
+	sensitivity string
+	// columns matches column names in snake case.
+	columns *regexp.Regexp
+	// values matches each preview value of a column.
+	values *regexp.Regexp
+	// check is an additional check on values that match.
+	check func(string) bool
+	// dataTypes limits the classifier to columns of these types. Built-in
+	// classifiers skip columns of skippedTypes instead.
+	dataTypes map[string]bool
+}
+
+// skippedTypes are column types that cannot hold the data the built-in
+// classifiers look for, such as email_verified booleans.
+var skippedTypes = []string{"bool", "date", "time", "float", "double", "real"}
+
+var builtins = []classifier{
+	{
+		name:        "email",
+		sensitivity: "medium",
+		columns:     regexp.MustCompile(`(^|_)e_?mail(_?addr(ess)?)?$`),
+		values:      regexp.MustCompile(`^[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}$`),
+	},
+	{
+		name:        "phone",
+		sensitivity: "medium",
+		columns:     regexp.MustCompile(`(^|_)(phone|mobile|msisdn|telephone|tel)(_?(no|num|number))?$`),
+		values:      regexp.MustCompile(`^\+?[0-9][0-9 ().-]{5,18}[0-9]$`),
+		check:       digitsBetween(7, 15),
+	},
+	{
+		name:        "national_id",
+		sensitivity: "high",
+		columns:     regexp.MustCompile(`(^|_)(ssn|social_security(_?(no|num|number))?|national_?id|nin|nik|aadhaar|passport(_?(no|num|number))?|tax_?id|tin)$`),
+		values:      regexp.MustCompile(`^[0-9]{3}-[0-9]{2}-[0-9]{4}$`),
+	},
+	{
+		name:        "card_number",
+		sensitivity: "high",
+		columns:     regexp.MustCompile(`(^|_)(credit_?card(_?(no|num|number))?|card_?(no|num|number)|cc_?(no|num|number)|pan)$`),
+		values:      regexp.MustCompile(`^[0-9][0-9 -]{11,21}[0-9]$`),
+		check:       luhn,
+	},
+	{
+		name:        "secret",
+		sensitivity: "high",
+		columns:     regexp.MustCompile(`(^|_)(password|passwd|pwd|secret|token|api_?key|private_?key|access_?key|secret_?key|credentials?)(_(hash|digest))?$`),
+		values:      regexp.MustCompile(`(?s)^(AKIA[0-9A-Z]{16}|-----BEGIN [A-Z ]*PRIVATE KEY-----.*|gh[pousr]_[A-Za-z0-9]{36}|xox[abprs]-[A-Za-z0-9-]+)$`),
+	},
+}
+
+// appliesTo reports whether the classifier looks at columns of a type.
+func (c classifier) appliesTo(dataType string) bool {
+	dataType = normalizeType(dataType)
+	if c.dataTypes != nil {
+		return c.dataTypes[dataType]
+	}
+	for _, t := range skippedTypes {
+		if strings.Contains(dataType, t) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c classifier) matchesName(name string) bool {
+	return c.columns != nil && c.columns.MatchString(snakeCase(name))
+}
+
+// matchesValues reports whether at least minRatio of the non-empty values
+// match the classifier.
+func (c classifier) matchesValues(values []string, minRatio float64) bool {
+	if c.values == nil || len(values) == 0 {
+		return false
+	}
+
+	var matched int
+	for _, v := range values {
+		if c.values.MatchString(v) && (c.check == nil || c.check(v)) {
+			matched++
+		}
+	}
+	return float64(matched)/float64(len(values)) >= minRatio
+}
+
+// normalizeType lower cases a column type and drops its parameters, so
+// that VARCHAR(255) is varchar.
+func normalizeType(dataType string) string {
+	if i := strings.IndexByte(dataType, '('); i >= 0 {
+		dataType = dataType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(dataType))
+}
+
+// snakeCase turns column names such as userEmail or EMAIL-ADDRESS into
+// user_email and email_address.
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == ' ' || r == '.':
+			b.WriteRune('_')
+		case unicode.IsUpper(r):
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func digitsBetween(min, max int) func(string) bool {
+	return func(s string) bool {
+		n := countDigits(s)
+		return n >= min && n <= max
+	}
+}
+
+func countDigits(s string) int {
+	var n int
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			n++
+		}
+	}
+	return n
+}
+
+// luhn reports whether the digits of s pass the Luhn checksum used by
+// card numbers.
+func luhn(s string) bool {
+	var sum, n int
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if n%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && n <= 19 && sum%10 == 0
+}
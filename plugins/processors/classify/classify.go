@@ -0,0 +1,263 @@
+package classify
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	// Classifiers limits the built-in classifiers to run, all of them by default.
+	Classifiers []string `mapstructure:"classifiers" validate:"dive,oneof=email phone national_id card_number secret"`
+	Rules       []Rule   `mapstructure:"rules" validate:"dive"`
+	// MinMatchRatio is the share of the non-empty preview values of a column
+	// that must match a classifier for the column to be classified.
+	MinMatchRatio float64 `mapstructure:"min_match_ratio" validate:"gt=0,lte=1" default:"0.8"`
+}
+
+// Rule is a custom classifier.
+type Rule struct {
+	Name        string   `mapstructure:"name" validate:"required"`
+	Sensitivity string   `mapstructure:"sensitivity" validate:"required,oneof=low medium high"`
+	Columns     string   `mapstructure:"columns" validate:"omitempty,regexp"`
+	Values      string   `mapstructure:"values" validate:"omitempty,regexp"`
+	DataTypes   []string `mapstructure:"data_types"`
+}
+
+// Processor tags columns holding personal or secret data.
+type Processor struct {
+	plugins.BasePlugin
+	config      Config
+	classifiers []classifier
+	logger      log.Logger
+}
+
+var sampleConfig = `
+# Built-in classifiers to run, all of them by default
+classifiers: [email, phone, national_id, card_number, secret]
+# Custom classifiers, matching column names or preview values
+rules:
+  - name: customer_id
+    sensitivity: low
+    columns: "^(customer|cust)_?id$"
+# Share of preview values of a column that must match a classifier
+min_match_ratio: 0.8`
+
+var info = plugins.Info{
+	Description:  "Classify columns holding personal or secret data.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	enabled := make(map[string]bool)
+	for _, name := range p.config.Classifiers {
+		enabled[name] = true
+	}
+	p.classifiers = nil
+	for _, c := range builtins {
+		if len(enabled) == 0 || enabled[c.name] {
+			p.classifiers = append(p.classifiers, c)
+		}
+	}
+
+	for _, r := range p.config.Rules {
+		if r.Columns == "" && r.Values == "" && len(r.DataTypes) == 0 {
+			return fmt.Errorf("rule %q: set columns, values or data_types", r.Name)
+		}
+		c := classifier{name: r.Name, sensitivity: r.Sensitivity}
+		if r.Columns != "" {
+			c.columns = regexp.MustCompile(r.Columns)
+		}
+		if r.Values != "" {
+			c.values = regexp.MustCompile(r.Values)
+		}
+		if len(r.DataTypes) > 0 {
+			c.dataTypes = make(map[string]bool, len(r.DataTypes))
+			for _, t := range r.DataTypes {
+				c.dataTypes[normalizeType(t)] = true
+			}
+		}
+		if c.columns == nil && c.values == nil {
+			// a rule on data types only classifies every column of those types
+			c.columns = regexp.MustCompile(".*")
+		}
+		p.classifiers = append(p.classifiers, c)
+	}
+
+	return nil
+}
+
+// Process tags the columns of the entity with their classifications and
+// sets the sensitivity of the entity to that of its most sensitive column.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+	if entity.GetProperties() == nil {
+		return src, nil
+	}
+	props := entity.GetProperties().AsMap()
+	columns, ok := props["columns"].([]any)
+	if !ok || len(columns) == 0 {
+		return src, nil
+	}
+
+	previews := previewValues(props)
+	level := levels[stringValue(props["sensitivity"])]
+	classified := p.classifyColumns(columns, previews, &level)
+	if classified == 0 {
+		return src, nil
+	}
+	p.logger.Debug("classified columns", "record", entity.GetUrn(), "columns", classified)
+	for name, l := range levels {
+		if l == level {
+			props["sensitivity"] = name
+		}
+	}
+
+	newProps, err := structpb.NewStruct(props)
+	if err != nil {
+		return src, fmt.Errorf("set properties: %w", err)
+	}
+	entity.Properties = newProps
+
+	return models.NewRecord(entity, src.Edges()...), nil
+}
+
+// classifyColumns tags the columns, including nested ones, and returns the
+// number of classified columns. level is raised to the highest sensitivity.
+func (p *Processor) classifyColumns(columns []any, previews map[string][]string, level *int) int {
+	var classified int
+	for _, c := range columns {
+		col, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if nested, ok := col["columns"].([]any); ok {
+			classified += p.classifyColumns(nested, nil, level)
+		}
+
+		name := stringValue(col["name"])
+		tags := existingTags(col["classifications"])
+		var found bool
+		for _, cl := range p.classifiers {
+			if !cl.appliesTo(stringValue(col["data_type"])) {
+				continue
+			}
+			if !cl.matchesName(name) && !cl.matchesValues(previews[name], p.config.MinMatchRatio) {
+				continue
+			}
+			found = true
+			if !contains(tags, cl.name) {
+				tags = append(tags, cl.name)
+			}
+			if l := levels[cl.sensitivity]; l > *level {
+				*level = l
+			}
+		}
+		if !found {
+			continue
+		}
+
+		classified++
+		list := make([]any, 0, len(tags))
+		for _, t := range tags {
+			list = append(list, t)
+		}
+		col["classifications"] = list
+	}
+	return classified
+}
+
+// previewValues returns the non-empty preview values of each column, from
+// the preview_fields and preview_rows properties of BigQuery tables.
+func previewValues(props map[string]any) map[string][]string {
+	fields, _ := props["preview_fields"].([]any)
+	rows, _ := props["preview_rows"].([]any)
+	if len(fields) == 0 || len(rows) == 0 {
+		return nil
+	}
+
+	values := make(map[string][]string, len(fields))
+	for _, r := range rows {
+		row, ok := r.([]any)
+		if !ok {
+			continue
+		}
+		for i, v := range row {
+			if i >= len(fields) {
+				break
+			}
+			if s := strings.TrimSpace(stringValue(v)); s != "" {
+				name := stringValue(fields[i])
+				values[name] = append(values[name], s)
+			}
+		}
+	}
+	return values
+}
+
+func existingTags(v any) []string {
+	list, _ := v.([]any)
+	tags := make([]string, 0, len(list))
+	for _, t := range list {
+		if s := stringValue(t); s != "" {
+			tags = append(tags, s)
+		}
+	}
+	return tags
+}
+
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	if err := registry.Processors.Register("classify", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,176 @@
+//go:build plugins
+
+package classify_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/classify"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	t.Run("should return error for unknown classifiers", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{
+			RawConfig: map[string]any{"classifiers": []any{"emails"}},
+		})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return error for invalid rule patterns", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{
+			RawConfig: map[string]any{"rules": []any{
+				map[string]any{"name": "broken", "sensitivity": "low", "columns": "(id"},
+			}},
+		})
+		assert.ErrorContains(t, err, "must be a valid regular expression")
+	})
+
+	t.Run("should return error for rules without a matcher", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{
+			RawConfig: map[string]any{"rules": []any{
+				map[string]any{"name": "empty", "sensitivity": "low"},
+			}},
+		})
+		assert.ErrorContains(t, err, `rule "empty": set columns, values or data_types`)
+	})
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should classify columns by name and type", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{}}))
+
+		rec := models.NewRecord(models.NewEntity("urn:postgres:db:table:users", "table", "users", "postgres", map[string]any{
+			"columns": []any{
+				map[string]any{"name": "id", "data_type": "integer"},
+				map[string]any{"name": "userEmail", "data_type": "character varying"},
+				map[string]any{"name": "email_verified", "data_type": "boolean"},
+				map[string]any{"name": "phone_number", "data_type": "text"},
+				map[string]any{"name": "password_hash", "data_type": "VARCHAR(255)"},
+			},
+		}))
+
+		dst, err := p.Process(ctx, rec)
+		require.NoError(t, err)
+
+		props := dst.Entity().GetProperties().AsMap()
+		assert.Equal(t, []any{nil, []any{"email"}, nil, []any{"phone"}, []any{"secret"}}, columnTags(props))
+		assert.Equal(t, "high", props["sensitivity"])
+	})
+
+	t.Run("should classify columns by preview values", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"min_match_ratio": 0.5}}))
+
+		rec := models.NewRecord(models.NewEntity("urn:bigquery:project:table:project:ds.orders", "table", "orders", "bigquery", map[string]any{
+			"columns": []any{
+				map[string]any{"name": "contact", "data_type": "STRING"},
+				map[string]any{"name": "payment", "data_type": "STRING"},
+				map[string]any{"name": "note", "data_type": "STRING"},
+			},
+			"preview_fields": []any{"contact", "payment", "note"},
+			"preview_rows": []any{
+				[]any{"jane@example.com", "4111 1111 1111 1111", "call back"},
+				[]any{"john@example.org", "4111 1111 1111 1112", "4111 1111 1111 1111"},
+				[]any{"n/a", "", "ok"},
+			},
+		}))
+
+		dst, err := p.Process(ctx, rec)
+		require.NoError(t, err)
+
+		props := dst.Entity().GetProperties().AsMap()
+		// the second card number fails the Luhn check, so only 1 of 2 values match
+		assert.Equal(t, []any{[]any{"email"}, []any{"card_number"}, nil}, columnTags(props))
+		assert.Equal(t, "high", props["sensitivity"])
+	})
+
+	t.Run("should apply custom rules to nested columns", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"classifiers": []any{"email"},
+			"rules": []any{
+				map[string]any{"name": "customer_id", "sensitivity": "low", "columns": "^customer_id$"},
+				map[string]any{"name": "binary", "sensitivity": "medium", "data_types": []any{"bytes"}},
+			},
+		}}))
+
+		rec := models.NewRecord(models.NewEntity("urn:bigquery:project:table:project:ds.orders", "table", "orders", "bigquery", map[string]any{
+			"sensitivity": "low",
+			"columns": []any{
+				map[string]any{"name": "customer", "data_type": "RECORD", "columns": []any{
+					map[string]any{"name": "customerId", "data_type": "STRING", "classifications": []any{"identifier"}},
+					map[string]any{"name": "avatar", "data_type": "BYTES"},
+				}},
+				map[string]any{"name": "password", "data_type": "STRING"},
+			},
+		}))
+
+		dst, err := p.Process(ctx, rec)
+		require.NoError(t, err)
+
+		props := dst.Entity().GetProperties().AsMap()
+		nested := props["columns"].([]any)[0].(map[string]any)
+		assert.Equal(t, []any{[]any{"identifier", "customer_id"}, []any{"binary"}}, columnTags(nested))
+		assert.Equal(t, []any{nil, nil}, columnTags(props), "secret classifier is not enabled")
+		assert.Equal(t, "medium", props["sensitivity"])
+	})
+
+	t.Run("should keep a higher sensitivity of the entity", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{}}))
+
+		rec := models.NewRecord(models.NewEntity("urn:postgres:db:table:users", "table", "users", "postgres", map[string]any{
+			"sensitivity": "high",
+			"columns":     []any{map[string]any{"name": "email", "data_type": "text"}},
+		}))
+
+		dst, err := p.Process(ctx, rec)
+		require.NoError(t, err)
+		assert.Equal(t, "high", dst.Entity().GetProperties().AsMap()["sensitivity"])
+	})
+
+	t.Run("should pass through entities without classified columns", func(t *testing.T) {
+		p := classify.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{}}))
+
+		for _, rec := range []models.Record{
+			models.NewRecord(models.NewEntity("urn:metabase:scope:dashboard:1", "dashboard", "sales", "metabase", nil)),
+			models.NewRecord(models.NewEntity("urn:postgres:db:table:orders", "table", "orders", "postgres", map[string]any{
+				"columns": []any{map[string]any{"name": "amount", "data_type": "numeric"}},
+			})),
+		} {
+			dst, err := p.Process(ctx, rec)
+			require.NoError(t, err)
+			assert.Equal(t, rec, dst)
+		}
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:              func() plugins.Processor { return classify.New(testutils.Logger) },
+		EmptyConfigValid: true,
+		Reachable:        true,
+	}.Run(t)
+}
+
+func columnTags(props map[string]any) []any {
+	var tags []any
+	for _, c := range props["columns"].([]any) {
+		tags = append(tags, c.(map[string]any)["classifications"])
+	}
+	return tags
+}
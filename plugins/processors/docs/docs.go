@@ -0,0 +1,162 @@
+package docs
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+
+	"github.com/raystack/meteor/models"
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	// Path is a YAML or Markdown file, or a directory of them.
+	Path string `mapstructure:"path" validate:"required"`
+	// Prefer is the description kept when both the source and the docs have
+	// one.
+	Prefer string `mapstructure:"prefer" validate:"oneof=docs source" default:"docs"`
+}
+
+// Processor merges curated descriptions, tags and owners into entities.
+type Processor struct {
+	plugins.BasePlugin
+	config Config
+	docs   map[string]doc
+	logger log.Logger
+}
+
+var sampleConfig = `
+# YAML or Markdown file, or a directory of them, documenting entities by URN
+path: ./catalog
+# Description kept when both the source and the docs have one: docs or source
+prefer: docs`
+
+var info = plugins.Info{
+	Description:  "Merge curated descriptions, tags and owners from files.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	if p.docs, err = readDocs(p.config.Path); err != nil {
+		return err
+	}
+	p.logger.Debug("read docs", "path", p.config.Path, "entities", len(p.docs))
+
+	return nil
+}
+
+// Process merges the docs of the entity into its description, tags,
+// columns and owners.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+	d, ok := p.docs[entity.GetUrn()]
+	if !ok {
+		return src, nil
+	}
+
+	if p.prefer(entity.GetDescription(), d.Description) {
+		entity.Description = d.Description
+	}
+
+	if len(d.Tags) > 0 || len(d.Columns) > 0 {
+		props := entity.GetProperties().AsMap()
+		if len(d.Tags) > 0 {
+			props["tags"] = mergeTags(props["tags"], d.Tags)
+		}
+		if columns, ok := props["columns"].([]any); ok && len(d.Columns) > 0 {
+			p.mergeColumns(columns, "", d.Columns)
+		}
+		if entity.Properties, err = structpb.NewStruct(props); err != nil {
+			return src, fmt.Errorf("set properties: %w", err)
+		}
+	}
+
+	edges := slices.Clone(src.Edges())
+	for _, owner := range d.Owners {
+		if !slices.ContainsFunc(edges, func(e *meteorv1beta1.Edge) bool {
+			return e.GetType() == "owned_by" && e.GetTargetUrn() == owner
+		}) {
+			edges = append(edges, models.OwnerEdge(entity.GetUrn(), owner, entity.GetSource()))
+		}
+	}
+
+	return models.NewRecord(entity, edges...), nil
+}
+
+// mergeColumns merges the docs of columns, including nested ones documented
+// by their dotted path, such as address.city.
+func (p *Processor) mergeColumns(columns []any, prefix string, docs map[string]column) {
+	for _, c := range columns {
+		col, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := col["name"].(string)
+		path := prefix + name
+		if nested, ok := col["columns"].([]any); ok {
+			p.mergeColumns(nested, path+".", docs)
+		}
+
+		d, ok := docs[path]
+		if !ok {
+			continue
+		}
+		description, _ := col["description"].(string)
+		if p.prefer(description, d.Description) {
+			col["description"] = d.Description
+		}
+		if len(d.Tags) > 0 {
+			col["tags"] = mergeTags(col["tags"], d.Tags)
+		}
+	}
+}
+
+// prefer reports whether the description from the docs replaces the one of
+// the source.
+func (p *Processor) prefer(source, docs string) bool {
+	return docs != "" && (source == "" || p.config.Prefer == "docs")
+}
+
+// mergeTags appends the tags that are not in existing yet.
+func mergeTags(existing any, tags []string) []any {
+	list, _ := existing.([]any)
+	for _, t := range tags {
+		if !slices.Contains(list, any(t)) {
+			list = append(list, t)
+		}
+	}
+	return list
+}
+
+func init() {
+	if err := registry.Processors.Register("docs", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,157 @@
+//go:build plugins
+
+package docs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/docs"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	cases := []struct {
+		name   string
+		config map[string]any
+		err    string
+	}{
+		{
+			name:   "missing path",
+			config: map[string]any{"path": "testdata/missing"},
+			err:    "open docs",
+		},
+		{
+			name:   "unsupported file",
+			config: map[string]any{"path": "testdata/docs/marts/notes.txt"},
+			err:    `docs "testdata/docs/marts/notes.txt": use a .yaml, .yml or .md file, or a directory`,
+		},
+		{
+			name:   "urn documented twice",
+			config: map[string]any{"path": "testdata/conflict"},
+			err:    `urn "urn:bigquery:prod:table:shop:sales.orders" is documented in both "testdata/conflict/a.yaml" and "testdata/conflict/b.md"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run("should return error for "+tc.name, func(t *testing.T) {
+			p := docs.New(testutils.Logger)
+			err := p.Init(context.Background(), plugins.Config{RawConfig: tc.config})
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	t.Run("should return error for unknown prefer", func(t *testing.T) {
+		p := docs.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{"path": "testdata/docs", "prefer": "curated"}})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+
+	orders := func() models.Record {
+		urn := "urn:bigquery:prod:table:shop:sales.orders"
+		entity := models.NewEntity(urn, "table", "orders", "bigquery", map[string]any{
+			"tags": []any{"core"},
+			"columns": []any{
+				map[string]any{"name": "id", "data_type": "INT64", "description": "ID"},
+				map[string]any{"name": "amount", "data_type": "INT64"},
+				map[string]any{"name": "shipping", "data_type": "RECORD", "columns": []any{
+					map[string]any{"name": "city", "data_type": "STRING"},
+				}},
+			},
+		})
+		entity.Description = "Orders."
+		return models.NewRecord(entity,
+			models.OwnerEdge(urn, "urn:user:jane@example.com", "bigquery"),
+			models.DerivedFromEdge(urn, "urn:bigquery:prod:table:shop:raw.orders", "bigquery"),
+		)
+	}
+
+	t.Run("should prefer descriptions of docs", func(t *testing.T) {
+		p := docs.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"path": "testdata/docs"}}))
+
+		dst, err := p.Process(ctx, orders())
+		require.NoError(t, err)
+
+		entity := dst.Entity()
+		assert.Equal(t, "Orders placed on the store, one row per order.", entity.GetDescription())
+		assert.Equal(t, map[string]any{
+			"tags": []any{"core", "finance"},
+			"columns": []any{
+				map[string]any{"name": "id", "data_type": "INT64", "description": "Identifier of the order."},
+				map[string]any{"name": "amount", "data_type": "INT64", "description": "Total of the order in cents.", "tags": []any{"money"}},
+				map[string]any{"name": "shipping", "data_type": "RECORD", "columns": []any{
+					map[string]any{"name": "city", "data_type": "STRING", "description": "City the order is shipped to."},
+				}},
+			},
+		}, entity.GetProperties().AsMap())
+
+		var owners []string
+		for _, e := range dst.Edges() {
+			if e.GetType() == "owned_by" {
+				owners = append(owners, e.GetTargetUrn())
+			}
+		}
+		assert.Equal(t, []string{"urn:user:jane@example.com", "urn:team:payments"}, owners)
+		assert.Len(t, dst.Edges(), 3)
+	})
+
+	t.Run("should prefer descriptions of the source", func(t *testing.T) {
+		p := docs.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"path": "testdata/docs", "prefer": "source"}}))
+
+		dst, err := p.Process(ctx, orders())
+		require.NoError(t, err)
+
+		entity := dst.Entity()
+		assert.Equal(t, "Orders.", entity.GetDescription())
+		columns := entity.GetProperties().AsMap()["columns"].([]any)
+		assert.Equal(t, "ID", columns[0].(map[string]any)["description"])
+		assert.Equal(t, "Total of the order in cents.", columns[1].(map[string]any)["description"])
+	})
+
+	t.Run("should read docs from markdown", func(t *testing.T) {
+		p := docs.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"path": "testdata/docs/marts/customers.md"}}))
+
+		src := models.NewRecord(models.NewEntity("urn:bigquery:prod:table:shop:marts.customers", "table", "customers", "bigquery", map[string]any{
+			"columns": []any{map[string]any{"name": "email", "data_type": "STRING"}},
+		}))
+		dst, err := p.Process(ctx, src)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Customers with at least one order.\n\nBuilt daily from `sales.orders`.", dst.Entity().GetDescription())
+		assert.Equal(t, map[string]any{
+			"tags": []any{"core"},
+			"columns": []any{
+				map[string]any{"name": "email", "data_type": "STRING", "description": "Contact email of the customer.", "tags": []any{"pii"}},
+			},
+		}, dst.Entity().GetProperties().AsMap())
+	})
+
+	t.Run("should pass through undocumented entities", func(t *testing.T) {
+		p := docs.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"path": "testdata/docs"}}))
+
+		src := models.NewRecord(models.NewEntity("urn:bigquery:prod:table:shop:sales.items", "table", "items", "bigquery", nil))
+		dst, err := p.Process(ctx, src)
+		require.NoError(t, err)
+		assert.Equal(t, src, dst)
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:       func() plugins.Processor { return docs.New(testutils.Logger) },
+		Config:    plugins.Config{RawConfig: map[string]any{"path": "testdata/docs"}},
+		Reachable: true,
+	}.Run(t)
+}
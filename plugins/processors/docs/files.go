@@ -0,0 +1,145 @@
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// doc is the curated documentation of an entity.
+type doc struct {
+	URN         string            `yaml:"urn"`
+	Description string            `yaml:"description"`
+	Tags        []string          `yaml:"tags"`
+	Owners      []string          `yaml:"owners"`
+	Columns     map[string]column `yaml:"columns"`
+	// file is the file the doc was read from.
+	file string
+}
+
+// column is the curated documentation of a column, written as its
+// description alone or as a map with a description and tags.
+type column struct {
+	Description string   `yaml:"description"`
+	Tags        []string `yaml:"tags"`
+}
+
+func (c *column) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&c.Description)
+	}
+	type plain column
+	return node.Decode((*plain)(c))
+}
+
+var frontMatter = []byte("---")
+
+// readDocs reads the docs of a YAML or Markdown file, or of the YAML and
+// Markdown files in a directory and its subdirectories other than hidden
+// ones, such as .github, by URN.
+func readDocs(path string) (map[string]doc, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("open docs: %w", err)
+	}
+
+	docs := make(map[string]doc)
+	if !info.IsDir() {
+		if !isDocFile(path) {
+			return nil, fmt.Errorf("docs %q: use a .yaml, .yml or .md file, or a directory", path)
+		}
+		return docs, readFile(path, docs)
+	}
+
+	root := path
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isDocFile(path) {
+			return nil
+		}
+		return readFile(path, docs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func isDocFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".md":
+		return true
+	}
+	return false
+}
+
+// readFile adds the docs of a file to docs. YAML files map URNs to docs.
+// Markdown files document a single entity, with its URN, tags, owners and
+// columns in a YAML front matter and its description in the body; those
+// without a front matter, such as a README, are skipped.
+func readFile(path string, docs map[string]doc) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("open docs: %w", err)
+	}
+
+	if strings.ToLower(filepath.Ext(path)) != ".md" {
+		var byURN map[string]doc
+		if err := yaml.Unmarshal(data, &byURN); err != nil {
+			return fmt.Errorf("read docs %q: %w", path, err)
+		}
+		for urn, d := range byURN {
+			if urn == "" {
+				return fmt.Errorf("read docs %q: empty urn", path)
+			}
+			d.URN = urn
+			if err := addDoc(docs, d, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	if !bytes.HasPrefix(data, frontMatter) {
+		return nil
+	}
+	head, body, ok := bytes.Cut(data[len(frontMatter):], append([]byte("\n"), frontMatter...))
+	if !ok {
+		return fmt.Errorf("read docs %q: front matter is not closed", path)
+	}
+
+	var d doc
+	if err := yaml.Unmarshal(head, &d); err != nil {
+		return fmt.Errorf("read docs %q: %w", path, err)
+	}
+	if d.URN == "" {
+		return fmt.Errorf("read docs %q: set urn in the front matter", path)
+	}
+	if body := strings.TrimSpace(string(body)); body != "" {
+		d.Description = body
+	}
+	return addDoc(docs, d, path)
+}
+
+func addDoc(docs map[string]doc, d doc, file string) error {
+	if existing, ok := docs[d.URN]; ok {
+		return fmt.Errorf("urn %q is documented in both %q and %q", d.URN, existing.file, file)
+	}
+	d.file = file
+	docs[d.URN] = d
+	return nil
+}
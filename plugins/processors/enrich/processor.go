@@ -7,6 +7,7 @@ import (
 
 	"github.com/raystack/meteor/models"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
 	"github.com/raystack/meteor/registry"
 	log "github.com/raystack/salt/observability/logger"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -16,21 +17,27 @@ import (
 var summary string
 
 type Config struct {
+	// Attributes are set in the entity properties. Strings, at any depth,
+	// may be Go templates evaluated against the entity.
 	Attributes map[string]any `mapstructure:"attributes" validate:"required"`
 }
 
 // Processor work in a list of data
 type Processor struct {
 	plugins.BasePlugin
-	config Config
-	logger log.Logger
+	config     Config
+	attributes map[string]*tmplutil.Value
+	logger     log.Logger
 }
 
 var sampleConfig = `
-# Enrichment configuration
+# Enrichment configuration, values keep their type and strings may be
+# templates with .urn, .type, .name, .description, .source, .service,
+# .scope, .id and .properties of the entity
 # attributes:
 #   fieldA: valueA
-#   fieldB: valueB`
+#   fieldB: 42
+#   team: '{{ "{{" }} .properties.labels.owner | default "unknown" }}'`
 
 var info = plugins.Info{
 	Description:  "Append custom fields to records.",
@@ -55,7 +62,14 @@ func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error)
 		return err
 	}
 
-	return
+	p.attributes = make(map[string]*tmplutil.Value, len(p.config.Attributes))
+	for key, value := range p.config.Attributes {
+		if p.attributes[key], err = tmplutil.Parse(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Process processes the data
@@ -73,10 +87,14 @@ func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.
 	}
 
 	// update custom properties using value from config
-	for key, value := range p.config.Attributes {
-		stringVal, ok := value.(string)
+	data := tmplutil.Data(entity)
+	for key, value := range p.attributes {
+		rendered, ok, err := value.Render(data)
+		if err != nil {
+			return src, fmt.Errorf("enrich %q: attribute %q: %w", entity.GetUrn(), key, err)
+		}
 		if ok {
-			customProps[key] = stringVal
+			customProps[key] = rendered
 		}
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/raystack/meteor/models"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/plugins/processors/enrich"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +35,18 @@ func TestInit(t *testing.T) {
 		})
 		assert.NoError(t, err)
 	})
+
+	t.Run("should return error for invalid template", func(t *testing.T) {
+		proc := enrich.New(testutils.Logger)
+		err := proc.Init(context.Background(), plugins.Config{
+			RawConfig: map[string]any{
+				"attributes": map[string]any{
+					"team": "{{ .properties.owner",
+				},
+			},
+		})
+		assert.ErrorContains(t, err, `parse template "team"`)
+	})
 }
 
 func TestProcess(t *testing.T) {
@@ -106,7 +119,7 @@ func TestProcess(t *testing.T) {
 		assert.Equal(t, owner, result.Edges()[1])
 	})
 
-	t.Run("should only add string values and skip non-string values", func(t *testing.T) {
+	t.Run("should keep the type of values", func(t *testing.T) {
 		proc := enrich.New(testutils.Logger)
 		err := proc.Init(context.Background(), plugins.Config{
 			RawConfig: map[string]any{
@@ -115,6 +128,8 @@ func TestProcess(t *testing.T) {
 					"count":    42,
 					"enabled":  true,
 					"fraction": 3.14,
+					"tags":     []any{"finance", 7},
+					"cost":     map[string]any{"currency": "USD", "budget": 100},
 				},
 			},
 		})
@@ -126,10 +141,74 @@ func TestProcess(t *testing.T) {
 		result, err := proc.Process(context.Background(), rec)
 		require.NoError(t, err)
 
+		assert.Equal(t, map[string]any{
+			"team":     "data-engineering",
+			"count":    float64(42),
+			"enabled":  true,
+			"fraction": 3.14,
+			"tags":     []any{"finance", float64(7)},
+			"cost":     map[string]any{"currency": "USD", "budget": float64(100)},
+		}, result.Entity().GetProperties().AsMap())
+	})
+
+	t.Run("should evaluate templates against the entity", func(t *testing.T) {
+		proc := enrich.New(testutils.Logger)
+		err := proc.Init(context.Background(), plugins.Config{
+			RawConfig: map[string]any{
+				"attributes": map[string]any{
+					"team":   `{{ .properties.labels.owner | default "unknown" }}`,
+					"env":    `{{ regexFind "prod|stg" .urn }}`,
+					"region": `{{ .properties.region }}`,
+					"origin": map[string]any{
+						"service": "{{ .service }}",
+						"names":   []any{"{{ .name | upper }}", "{{ .properties.alias }}"},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		entity := models.NewEntity("urn:bigquery:prod:table:shop.orders", "table", "orders", "bigquery", map[string]any{
+			"labels": map[string]any{"owner": "sales"},
+		})
+
+		result, err := proc.Process(context.Background(), models.NewRecord(entity))
+		require.NoError(t, err)
+
 		props := result.Entity().GetProperties().AsMap()
-		assert.Equal(t, "data-engineering", props["team"])
-		assert.Nil(t, props["count"])
-		assert.Nil(t, props["enabled"])
-		assert.Nil(t, props["fraction"])
+		assert.Equal(t, "sales", props["team"])
+		assert.Equal(t, "prod", props["env"])
+		assert.NotContains(t, props, "region", "templates rendering empty are left out")
+		assert.Equal(t, map[string]any{"service": "bigquery", "names": []any{"ORDERS"}}, props["origin"])
+
+		entity = models.NewEntity("urn:bigquery:dev:table:shop.orders", "table", "orders", "bigquery", nil)
+		result, err = proc.Process(context.Background(), models.NewRecord(entity))
+		require.NoError(t, err)
+
+		props = result.Entity().GetProperties().AsMap()
+		assert.Equal(t, "unknown", props["team"])
+		assert.NotContains(t, props, "env")
+	})
+
+	t.Run("should return error for failing template", func(t *testing.T) {
+		proc := enrich.New(testutils.Logger)
+		err := proc.Init(context.Background(), plugins.Config{
+			RawConfig: map[string]any{
+				"attributes": map[string]any{"env": `{{ regexFind "(" .urn }}`},
+			},
+		})
+		require.NoError(t, err)
+
+		entity := models.NewEntity("urn:table:1", "table", "my-table", "bigquery", nil)
+		_, err = proc.Process(context.Background(), models.NewRecord(entity))
+		assert.ErrorContains(t, err, `enrich "urn:table:1": attribute "env"`)
 	})
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:       func() plugins.Processor { return enrich.New(testutils.Logger) },
+		Config:    plugins.Config{RawConfig: map[string]any{"attributes": map[string]any{"team": "data"}}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -0,0 +1,144 @@
+package httpenrich
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/raystack/meteor/metrics/otelhttpclient"
+	"github.com/raystack/meteor/plugins"
+)
+
+// client calls the service, caching its responses, including the entities
+// it does not know, and limiting the requests in flight.
+type client struct {
+	config Config
+	http   *http.Client
+	slots  chan struct{}
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+type cached struct {
+	body    any
+	expires time.Time
+}
+
+type request struct {
+	method string
+	url    string
+	body   string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		config: config,
+		http: &http.Client{
+			Timeout:   config.Timeout,
+			Transport: otelhttpclient.NewHTTPTransport(nil),
+		},
+		slots: make(chan struct{}, config.Concurrency),
+		cache: make(map[string]cached),
+	}
+}
+
+// get returns the decoded response to the request, or nil when the service
+// responds with 404.
+func (c *client) get(ctx context.Context, req request) (any, error) {
+	key := req.method + " " + req.url + "\n" + req.body
+	if c.config.CacheTTL > 0 {
+		c.mu.Lock()
+		hit, ok := c.cache[key]
+		c.mu.Unlock()
+		if ok && time.Now().Before(hit.expires) {
+			return hit.body, nil
+		}
+	}
+
+	body, err := c.retry(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.config.CacheTTL > 0 {
+		c.mu.Lock()
+		c.cache[key] = cached{body: body, expires: time.Now().Add(c.config.CacheTTL)}
+		c.mu.Unlock()
+	}
+	return body, nil
+}
+
+// retry sends the request until it succeeds, fails with an error that is
+// not a plugins.RetryError, or runs out of retries.
+func (c *client) retry(ctx context.Context, req request) (any, error) {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = c.config.RetryInterval
+
+	var body any
+	err := backoff.Retry(func() error {
+		var err error
+		body, err = c.send(ctx, req)
+		if err != nil && !errors.As(err, &plugins.RetryError{}) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, backoff.WithContext(backoff.WithMaxRetries(bo, uint64(c.config.Retries)), ctx))
+	return body, err
+}
+
+func (c *client) send(ctx context.Context, req request) (any, error) {
+	select {
+	case c.slots <- struct{}{}:
+		defer func() { <-c.slots }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	var payload io.Reader
+	if req.body != "" {
+		payload = strings.NewReader(req.body)
+	}
+	hreq, err := http.NewRequestWithContext(ctx, req.method, req.url, payload)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	hreq.Header.Set("Accept", "application/json")
+	if payload != nil {
+		hreq.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range c.config.Headers {
+		hreq.Header.Set(k, v)
+	}
+
+	res, err := c.http.Do(hreq)
+	if err != nil {
+		return nil, plugins.NewRetryError(fmt.Errorf("%s %s: %w", req.method, req.url, err))
+	}
+	defer plugins.DrainBody(res)
+
+	switch code := res.StatusCode; {
+	case code == http.StatusNotFound:
+		return nil, nil
+	case code < 200 || code >= 300:
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		err := fmt.Errorf("%s %s: status %d: %s", req.method, req.url, code, strings.TrimSpace(string(msg)))
+		if code >= 500 || code == http.StatusTooManyRequests {
+			return nil, plugins.NewRetryError(err)
+		}
+		return nil, err
+	}
+
+	var body any
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("%s %s: decode response: %w", req.method, req.url, err)
+	}
+	return body, nil
+}
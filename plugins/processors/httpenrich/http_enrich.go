@@ -0,0 +1,207 @@
+package httpenrich
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	// URL is a Go template of the URL called for each entity.
+	URL     string            `mapstructure:"url" validate:"required"`
+	Method  string            `mapstructure:"method" validate:"oneof=GET POST" default:"GET"`
+	Headers map[string]string `mapstructure:"headers"`
+	// Body is a Go template of the body of POST requests.
+	Body string `mapstructure:"body"`
+	// Fields maps dotted paths in the entity properties to dotted paths in
+	// the JSON response.
+	Fields map[string]string `mapstructure:"fields" validate:"required,min=1"`
+	// CacheTTL is how long responses are reused for, 0 to not cache them.
+	CacheTTL time.Duration `mapstructure:"cache_ttl" default:"1h"`
+	Timeout  time.Duration `mapstructure:"timeout" default:"10s"`
+	// Concurrency caps the requests in flight across the sources of a
+	// recipe. Records of one source are processed one at a time.
+	Concurrency   int           `mapstructure:"concurrency" validate:"min=1" default:"4"`
+	Retries       int           `mapstructure:"retries" validate:"min=0" default:"3"`
+	RetryInterval time.Duration `mapstructure:"retry_interval" default:"1s"`
+	// FailOpen passes records through unchanged when the service fails,
+	// instead of failing the run.
+	FailOpen bool `mapstructure:"fail_open"`
+}
+
+// Processor sets properties of entities from the response of an HTTP
+// service.
+type Processor struct {
+	plugins.BasePlugin
+	config Config
+	url    *template.Template
+	body   *template.Template
+	fields []string
+	client *client
+	logger log.Logger
+}
+
+var sampleConfig = `
+# URL called for each entity, with .urn, .type, .name, .source, .service,
+# .scope, .id and .properties of the entity
+url: 'https://catalog.example.com/api/assets/{{ "{{" }} .urn | urlquery }}'
+method: GET
+headers:
+  Authorization: Bearer token
+# Dotted paths in entity.properties set from dotted paths in the response
+fields:
+  tier: data.tier
+  cost.monthly: data.billing.monthly_cost
+cache_ttl: 1h
+timeout: 10s
+# Requests in flight at the same time across the sources of the recipe
+concurrency: 4
+# Retries of network errors, 429 and 5xx responses
+retries: 3
+retry_interval: 1s
+# Pass records through unchanged when the service fails
+fail_open: false`
+
+var info = plugins.Info{
+	Description:  "Enrich entities with the response of an HTTP service.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	if p.url, err = template.New("url").Option("missingkey=error").Funcs(tmplutil.Funcs()).Parse(p.config.URL); err != nil {
+		return fmt.Errorf("parse url template: %w", err)
+	}
+	p.body = nil
+	if p.config.Body != "" {
+		if p.body, err = template.New("body").Option("missingkey=error").Funcs(tmplutil.Funcs()).Parse(p.config.Body); err != nil {
+			return fmt.Errorf("parse body template: %w", err)
+		}
+	}
+
+	p.fields = make([]string, 0, len(p.config.Fields))
+	for property := range p.config.Fields {
+		p.fields = append(p.fields, property)
+	}
+	sort.Strings(p.fields)
+
+	p.client = newClient(p.config)
+
+	return nil
+}
+
+// Process calls the service for the entity and sets the configured fields
+// of the response in its properties.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+
+	res, err := p.fetch(ctx, entity.GetUrn(), tmplutil.Data(src.Entity()))
+	if err != nil {
+		if !p.config.FailOpen {
+			return src, err
+		}
+		p.logger.Warn("skipping http enrichment", "record", entity.GetUrn(), "error", err)
+		return src, nil
+	}
+	if res == nil {
+		return src, nil
+	}
+
+	props := entity.GetProperties().AsMap()
+	var set int
+	for _, property := range p.fields {
+		v := tmplutil.Field(res, p.config.Fields[property])
+		if v == nil {
+			continue
+		}
+		setPath(props, property, v)
+		set++
+	}
+	if set == 0 {
+		return src, nil
+	}
+
+	if entity.Properties, err = structpb.NewStruct(props); err != nil {
+		return src, fmt.Errorf("set properties: %w", err)
+	}
+
+	return models.NewRecord(entity, src.Edges()...), nil
+}
+
+func (p *Processor) fetch(ctx context.Context, urn string, data map[string]any) (any, error) {
+	req := request{method: p.config.Method}
+
+	var buf bytes.Buffer
+	if err := p.url.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("enrich %q: build url: %w", urn, err)
+	}
+	req.url = buf.String()
+
+	if p.body != nil {
+		buf.Reset()
+		if err := p.body.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("enrich %q: build body: %w", urn, err)
+		}
+		req.body = buf.String()
+	}
+
+	res, err := p.client.get(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("enrich %q: %w", urn, err)
+	}
+	return res, nil
+}
+
+// setPath sets v at a dotted path of props, creating the maps on the way.
+func setPath(props map[string]any, path string, v any) {
+	keys := strings.Split(path, ".")
+	m := props
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := m[key].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			m[key] = next
+		}
+		m = next
+	}
+	m[keys[len(keys)-1]] = v
+}
+
+func init() {
+	if err := registry.Processors.Register("http_enrich", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
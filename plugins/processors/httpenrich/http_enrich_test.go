@@ -0,0 +1,241 @@
+//go:build plugins
+
+package httpenrich_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/httpenrich"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	t.Run("should return error for config without fields", func(t *testing.T) {
+		p := httpenrich.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{"url": "http://localhost/{{ .urn }}"}})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return error for invalid url template", func(t *testing.T) {
+		p := httpenrich.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{
+			"url":    "http://localhost/{{ .urn",
+			"fields": map[string]any{"tier": "tier"},
+		}})
+		assert.ErrorContains(t, err, "parse url template")
+	})
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+	table := func(name string) models.Record {
+		urn := "urn:bigquery:prod:table:shop:sales." + name
+		return models.NewRecord(
+			models.NewEntity(urn, "table", name, "bigquery", map[string]any{"project": "shop", "cost": map[string]any{"currency": "USD"}}),
+			models.OwnerEdge(urn, "urn:user:jane@example.com", "bigquery"),
+		)
+	}
+
+	t.Run("should set fields of the response and cache it", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			assert.Equal(t, "/assets/shop/orders", r.URL.Path)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"tier":    "gold",
+				"billing": map[string]any{"monthly_cost": 120.5},
+				"labels":  []any{"finance"},
+			}}))
+		}))
+		defer srv.Close()
+
+		p := httpenrich.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"url":     srv.URL + "/assets/{{ .properties.project }}/{{ .name }}",
+			"headers": map[string]any{"Authorization": "Bearer token"},
+			"fields": map[string]any{
+				"tier":         "data.tier",
+				"cost.monthly": "data.billing.monthly_cost",
+				"tags":         "data.labels",
+				"missing":      "data.missing",
+			},
+		}}))
+
+		for i := 0; i < 3; i++ {
+			dst, err := p.Process(ctx, table("orders"))
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{
+				"project": "shop",
+				"tier":    "gold",
+				"cost":    map[string]any{"currency": "USD", "monthly": 120.5},
+				"tags":    []any{"finance"},
+			}, dst.Entity().GetProperties().AsMap())
+			assert.Len(t, dst.Edges(), 1)
+		}
+		assert.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("should post templated body", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.JSONEq(t, `{"urn": "urn:bigquery:prod:table:shop:sales.orders"}`, string(body))
+			fmt.Fprint(w, `{"tier": "silver"}`)
+		}))
+		defer srv.Close()
+
+		p := httpenrich.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"url":    srv.URL,
+			"method": "POST",
+			"body":   `{"urn": "{{ .urn }}"}`,
+			"fields": map[string]any{"tier": "tier"},
+		}}))
+
+		dst, err := p.Process(ctx, table("orders"))
+		require.NoError(t, err)
+		assert.Equal(t, "silver", dst.Entity().GetProperties().AsMap()["tier"])
+	})
+
+	t.Run("should retry failing requests", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) < 3 {
+				http.Error(w, "try again", http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprint(w, `{"tier": "gold"}`)
+		}))
+		defer srv.Close()
+
+		p := httpenrich.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"url":            srv.URL + "/{{ .urn }}",
+			"fields":         map[string]any{"tier": "tier"},
+			"retry_interval": "1ms",
+		}}))
+
+		dst, err := p.Process(ctx, table("orders"))
+		require.NoError(t, err)
+		assert.Equal(t, "gold", dst.Entity().GetProperties().AsMap()["tier"])
+		assert.EqualValues(t, 3, requests.Load())
+	})
+
+	t.Run("should fail closed or open", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			switch strings.TrimPrefix(r.URL.Path, "/") {
+			case "refunds":
+				http.Error(w, "forbidden", http.StatusForbidden)
+			case "items":
+				w.WriteHeader(http.StatusNotFound)
+			default:
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			}
+		}))
+		defer srv.Close()
+
+		config := map[string]any{
+			"url":            srv.URL + "/{{ .name }}",
+			"fields":         map[string]any{"tier": "tier"},
+			"retries":        2,
+			"retry_interval": "1ms",
+		}
+		p := httpenrich.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: config}))
+
+		_, err := p.Process(ctx, table("orders"))
+		assert.EqualError(t, err, fmt.Sprintf(`enrich "urn:bigquery:prod:table:shop:sales.orders": GET %s/orders: status 503: unavailable`, srv.URL))
+		assert.ErrorAs(t, err, &plugins.RetryError{})
+		assert.EqualValues(t, 3, requests.Load())
+
+		requests.Store(0)
+		_, err = p.Process(ctx, table("refunds"))
+		assert.ErrorContains(t, err, "status 403: forbidden")
+		assert.EqualValues(t, 1, requests.Load(), "client errors are not retried")
+
+		src := table("items")
+		dst, err := p.Process(ctx, src)
+		require.NoError(t, err)
+		assert.Equal(t, src, dst, "unknown entities are passed through")
+
+		config["fail_open"] = true
+		p = httpenrich.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: config}))
+
+		src = table("orders")
+		dst, err = p.Process(ctx, src)
+		require.NoError(t, err)
+		assert.Equal(t, src, dst)
+	})
+
+	t.Run("should limit requests in flight", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			fmt.Fprint(w, `{"tier": "gold"}`)
+		}))
+		defer srv.Close()
+
+		p := httpenrich.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"url":         srv.URL + "/{{ .name }}",
+			"fields":      map[string]any{"tier": "tier"},
+			"concurrency": 2,
+		}}))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, err := p.Process(ctx, table(fmt.Sprintf("table_%d", i)))
+				assert.NoError(t, err)
+			}(i)
+		}
+		wg.Wait()
+		assert.EqualValues(t, 2, maxInFlight.Load())
+	})
+}
+
+func TestConformance(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"tier": "gold"}`)
+	}))
+	defer srv.Close()
+
+	plugintest.ProcessorSuite{
+		New: func() plugins.Processor { return httpenrich.New(testutils.Logger) },
+		Config: plugins.Config{RawConfig: map[string]any{
+			"url":    srv.URL + "/{{ .urn | urlquery }}",
+			"fields": map[string]any{"tier": "tier"},
+		}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -3,9 +3,11 @@ package labels
 import (
 	"context"
 	_ "embed"
+	"fmt"
 
 	"github.com/raystack/meteor/models"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
 	"github.com/raystack/meteor/registry"
 	log "github.com/raystack/salt/observability/logger"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -15,6 +17,8 @@ import (
 var summary string
 
 type Config struct {
+	// Labels are merged into the labels of the entity. Values may be Go
+	// templates evaluated against the entity.
 	Labels map[string]string `mapstructure:"labels" validate:"required"`
 }
 
@@ -22,14 +26,16 @@ type Config struct {
 type Processor struct {
 	plugins.BasePlugin
 	config Config
+	labels map[string]*tmplutil.Value
 	logger log.Logger
 }
 
 var sampleConfig = `
-# Append labels to entity
+# Append labels to entity, values may be templates with .urn, .type, .name,
+# .description, .source, .service, .scope, .id and .properties of the entity
 # labels:
 #   fieldA: valueA
-#   fieldB: valueB`
+#   env: '{{ "{{" }} regexFind "prod|stg" .urn }}'`
 
 var info = plugins.Info{
 	Description:  "Append labels to entities.",
@@ -54,7 +60,14 @@ func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error)
 		return err
 	}
 
-	return
+	p.labels = make(map[string]*tmplutil.Value, len(p.config.Labels))
+	for key, value := range p.config.Labels {
+		if p.labels[key], err = tmplutil.Parse(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Process processes the data
@@ -76,9 +89,16 @@ func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.
 		labels = make(map[string]any)
 	}
 
-	// Merge config labels
-	for key, value := range p.config.Labels {
-		labels[key] = value
+	// Merge config labels, leaving out the ones rendering empty
+	data := tmplutil.Data(entity)
+	for key, value := range p.labels {
+		rendered, ok, err := value.Render(data)
+		if err != nil {
+			return src, fmt.Errorf("labels %q: label %q: %w", entity.GetUrn(), key, err)
+		}
+		if ok {
+			labels[key] = rendered
+		}
 	}
 	propMap["labels"] = labels
 
@@ -9,6 +9,7 @@ import (
 	"github.com/raystack/meteor/models"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/plugins/processors/labels"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,18 @@ func TestInit(t *testing.T) {
 		})
 		assert.NoError(t, err)
 	})
+
+	t.Run("should return error for invalid template", func(t *testing.T) {
+		p := labels.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{
+			RawConfig: map[string]any{
+				"labels": map[string]any{
+					"team": "{{ .properties.owner",
+				},
+			},
+		})
+		assert.ErrorContains(t, err, `parse template "team"`)
+	})
 }
 
 func TestProcess(t *testing.T) {
@@ -117,6 +130,33 @@ func TestProcess(t *testing.T) {
 		assert.Equal(t, "production", lbls["env"])
 	})
 
+	t.Run("should evaluate templates against the entity", func(t *testing.T) {
+		p := labels.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{
+			RawConfig: map[string]any{
+				"labels": map[string]any{
+					"team":   `{{ .properties.labels.owner | default "unknown" }}`,
+					"env":    `{{ regexFind "prod|stg" .urn }}`,
+					"source": "{{ .source }}",
+				},
+			},
+		}))
+
+		entity := models.NewEntity("urn:bigquery:stg:table:shop.orders", "table", "orders", "bigquery", map[string]any{
+			"labels": map[string]any{"owner": "sales"},
+		})
+		result, err := p.Process(ctx, models.NewRecord(entity))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"owner": "sales", "team": "sales", "env": "stg", "source": "bigquery"},
+			result.Entity().GetProperties().AsMap()["labels"])
+
+		entity = models.NewEntity("urn:bigquery:dev:table:shop.orders", "table", "orders", "bigquery", nil)
+		result, err = p.Process(ctx, models.NewRecord(entity))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"team": "unknown", "source": "bigquery"},
+			result.Entity().GetProperties().AsMap()["labels"], "labels rendering empty are left out")
+	})
+
 	t.Run("should preserve edges through processing", func(t *testing.T) {
 		p := labels.New(testutils.Logger)
 		require.NoError(t, p.Init(ctx, plugins.Config{
@@ -145,3 +185,11 @@ func TestProcess(t *testing.T) {
 		assert.Equal(t, "urn:test:scope:table:t5", resultEdges[1].TargetUrn)
 	})
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:       func() plugins.Processor { return labels.New(testutils.Logger) },
+		Config:    plugins.Config{RawConfig: map[string]any{"labels": map[string]any{"team": "data"}}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -0,0 +1,309 @@
+package mapper
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	Operations []Operation `mapstructure:"operations" validate:"required,min=1,dive"`
+}
+
+// Operation changes the entity properties at a dotted path. copy and move
+// read from and write to, the other operations work on path.
+type Operation struct {
+	Op    string `mapstructure:"op" validate:"required,oneof=copy move delete set default coerce"`
+	From  string `mapstructure:"from"`
+	To    string `mapstructure:"to"`
+	Path  string `mapstructure:"path"`
+	Value any    `mapstructure:"value"`
+	// Type is the type coerce converts values to.
+	Type string `mapstructure:"type" validate:"omitempty,oneof=string int float bool"`
+}
+
+type operation struct {
+	Operation
+	from, to, path path
+}
+
+// Processor reshapes the properties of entities.
+type Processor struct {
+	plugins.BasePlugin
+	config     Config
+	operations []operation
+	logger     log.Logger
+}
+
+var sampleConfig = `
+# Operations run in order on entity.properties
+operations:
+  - op: move
+    from: schema
+    to: columns
+  - op: copy
+    from: columns[*].type
+    to: columns[*].data_type
+  - op: coerce
+    path: columns[*].length
+    type: int
+  - op: default
+    path: labels.team
+    value: data-platform
+  - op: delete
+    path: preview_rows`
+
+var info = plugins.Info{
+	Description:  "Copy, move, delete and set entity properties.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	p.operations = make([]operation, 0, len(p.config.Operations))
+	for i, o := range p.config.Operations {
+		op, err := compile(o)
+		if err != nil {
+			return fmt.Errorf("operation %d (%s): %w", i+1, o.Op, err)
+		}
+		p.operations = append(p.operations, op)
+	}
+
+	return nil
+}
+
+func compile(o Operation) (op operation, err error) {
+	op.Operation = o
+	switch o.Op {
+	case "copy", "move":
+		if o.From == "" || o.To == "" {
+			return op, fmt.Errorf("set from and to")
+		}
+		if op.from, err = parsePath(o.From); err != nil {
+			return op, err
+		}
+		if op.to, err = parsePath(o.To); err != nil {
+			return op, err
+		}
+		if op.from.wildcards() != op.to.wildcards() {
+			return op, fmt.Errorf("from and to must have the same number of [*]")
+		}
+	default:
+		if o.Path == "" {
+			return op, fmt.Errorf("set path")
+		}
+		if o.Op == "coerce" && o.Type == "" {
+			return op, fmt.Errorf("set type")
+		}
+		if op.path, err = parsePath(o.Path); err != nil {
+			return op, err
+		}
+	}
+	return op, nil
+}
+
+// Process runs the operations on the properties of the entity.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+
+	var props map[string]any
+	if entity.GetProperties() != nil {
+		props = entity.GetProperties().AsMap()
+	}
+	if props == nil {
+		props = make(map[string]any)
+	}
+
+	for _, op := range p.operations {
+		if err := op.apply(props); err != nil {
+			return src, fmt.Errorf("%s %s: %w", op.Op, op.target(), err)
+		}
+	}
+
+	newProps, err := structpb.NewStruct(props)
+	if err != nil {
+		return src, fmt.Errorf("set properties: %w", err)
+	}
+	entity.Properties = newProps
+
+	return models.NewRecord(entity, src.Edges()...), nil
+}
+
+func (op operation) target() string {
+	if op.Path != "" {
+		return op.Path
+	}
+	return op.From + " to " + op.To
+}
+
+func (op operation) apply(props map[string]any) error {
+	switch op.Op {
+	case "copy", "move":
+		targets := expand(props, op.from)
+		for _, t := range targets {
+			v, ok := get(props, t.path)
+			if !ok {
+				continue
+			}
+			if err := set(props, op.to.withIndexes(t.indexes), deepCopy(v)); err != nil {
+				return err
+			}
+		}
+		if op.Op == "move" {
+			removeAll(props, targets)
+		}
+
+	case "delete":
+		removeAll(props, expand(props, op.path))
+
+	case "set", "default":
+		for _, t := range expand(props, op.path) {
+			if op.Op == "default" {
+				if v, ok := get(props, t.path); ok && v != nil && v != "" {
+					continue
+				}
+			}
+			if err := set(props, t.path, deepCopy(op.Value)); err != nil {
+				return err
+			}
+		}
+
+	case "coerce":
+		for _, t := range expand(props, op.path) {
+			v, ok := get(props, t.path)
+			if !ok || v == nil {
+				continue
+			}
+			v, err := coerce(v, op.Type)
+			if err != nil {
+				return err
+			}
+			if err := set(props, t.path, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeAll removes the targets last to first, so that removing an element
+// of a list does not shift the elements still to be removed.
+func removeAll(props map[string]any, targets []target) {
+	for _, t := range slices.Backward(targets) {
+		remove(props, t.path)
+	}
+}
+
+func coerce(v any, typ string) (any, error) {
+	switch typ {
+	case "string":
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		}
+
+	case "int", "float":
+		var f float64
+		switch v := v.(type) {
+		case float64:
+			f = v
+		case bool:
+			if v {
+				f = 1
+			}
+		case string:
+			var err error
+			if f, err = strconv.ParseFloat(strings.TrimSpace(v), 64); err != nil {
+				return nil, fmt.Errorf("cannot coerce %q to %s", v, typ)
+			}
+		default:
+			return nil, fmt.Errorf("cannot coerce a %s to %s", kind(v), typ)
+		}
+		if typ == "int" && f != math.Trunc(f) {
+			return nil, fmt.Errorf("cannot coerce %#v to int", v)
+		}
+		return f, nil
+
+	case "bool":
+		switch v := v.(type) {
+		case bool:
+			return v, nil
+		case float64:
+			return v != 0, nil
+		case string:
+			switch strings.ToLower(strings.TrimSpace(v)) {
+			case "yes", "y":
+				return true, nil
+			case "no", "n":
+				return false, nil
+			}
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, fmt.Errorf("cannot coerce %q to bool", v)
+			}
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot coerce a %s to %s", kind(v), typ)
+}
+
+// deepCopy copies objects and lists, so that a copied or set value does not
+// change with the value it was copied from.
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, el := range v {
+			m[k] = deepCopy(el)
+		}
+		return m
+	case []any:
+		list := make([]any, len(v))
+		for i, el := range v {
+			list[i] = deepCopy(el)
+		}
+		return list
+	}
+	return v
+}
+
+func init() {
+	if err := registry.Processors.Register("map", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,178 @@
+//go:build plugins
+
+package mapper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/mapper"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	cases := []struct {
+		name      string
+		operation map[string]any
+		expected  string
+	}{
+		{
+			name:      "unknown op",
+			operation: map[string]any{"op": "rename", "path": "a"},
+			expected:  "must be one of [copy move delete set default coerce]",
+		},
+		{
+			name:      "copy without to",
+			operation: map[string]any{"op": "copy", "from": "a"},
+			expected:  "operation 1 (copy): set from and to",
+		},
+		{
+			name:      "coerce without type",
+			operation: map[string]any{"op": "coerce", "path": "a"},
+			expected:  "operation 1 (coerce): set type",
+		},
+		{
+			name:      "invalid path",
+			operation: map[string]any{"op": "delete", "path": "columns[x].name"},
+			expected:  `path "columns[x].name": index "x" is not * or a number`,
+		},
+		{
+			name:      "empty key",
+			operation: map[string]any{"op": "delete", "path": "labels..team"},
+			expected:  `path "labels..team": empty key`,
+		},
+		{
+			name:      "different wildcards",
+			operation: map[string]any{"op": "move", "from": "columns[*].type", "to": "type"},
+			expected:  "from and to must have the same number of [*]",
+		},
+	}
+	for _, tc := range cases {
+		t.Run("should return error for "+tc.name, func(t *testing.T) {
+			p := mapper.New(testutils.Logger)
+			err := p.Init(context.Background(), plugins.Config{
+				RawConfig: map[string]any{"operations": []any{tc.operation}},
+			})
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+
+	process := func(t *testing.T, operations []any, props map[string]any) (map[string]any, error) {
+		t.Helper()
+		p := mapper.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"operations": operations}}))
+
+		dst, err := p.Process(ctx, models.NewRecord(models.NewEntity("urn:postgres:db:table:users", "table", "users", "postgres", props)))
+		if err != nil {
+			return nil, err
+		}
+		return dst.Entity().GetProperties().AsMap(), nil
+	}
+
+	t.Run("should run operations in order", func(t *testing.T) {
+		props, err := process(t, []any{
+			map[string]any{"op": "move", "from": "schema", "to": "columns"},
+			map[string]any{"op": "move", "from": "columns[*].type", "to": "columns[*].data_type"},
+			map[string]any{"op": "copy", "from": "columns[*].name", "to": "column_names[*]"},
+			map[string]any{"op": "coerce", "path": "columns[*].length", "type": "int"},
+			map[string]any{"op": "coerce", "path": "columns[*].is_nullable", "type": "bool"},
+			map[string]any{"op": "copy", "from": "owner", "to": "labels.owner"},
+			map[string]any{"op": "set", "path": "labels.source", "value": "meteor"},
+			map[string]any{"op": "default", "path": "labels.team", "value": "data-platform"},
+			map[string]any{"op": "default", "path": "description", "value": "no description"},
+			map[string]any{"op": "delete", "path": "preview_rows"},
+		}, map[string]any{
+			"schema": []any{
+				map[string]any{"name": "id", "type": "int", "length": "8", "is_nullable": "false"},
+				map[string]any{"name": "email", "type": "varchar", "length": 255.0, "is_nullable": "YES"},
+			},
+			"owner":        "jane@example.com",
+			"labels":       map[string]any{"team": "growth"},
+			"preview_rows": []any{[]any{1.0, "jane@example.com"}},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"columns": []any{
+				map[string]any{"name": "id", "data_type": "int", "length": 8.0, "is_nullable": false},
+				map[string]any{"name": "email", "data_type": "varchar", "length": 255.0, "is_nullable": true},
+			},
+			"column_names": []any{"id", "email"},
+			"owner":        "jane@example.com",
+			"labels":       map[string]any{"team": "growth", "owner": "jane@example.com", "source": "meteor"},
+			"description":  "no description",
+		}, props)
+	})
+
+	t.Run("should delete list elements", func(t *testing.T) {
+		props, err := process(t, []any{
+			map[string]any{"op": "delete", "path": "columns[1]"},
+			map[string]any{"op": "delete", "path": "columns[*].profile"},
+			map[string]any{"op": "delete", "path": "tags[*]"},
+		}, map[string]any{
+			"columns": []any{
+				map[string]any{"name": "a", "profile": map[string]any{"min": 1.0}},
+				map[string]any{"name": "b"},
+				map[string]any{"name": "c", "profile": map[string]any{"min": 2.0}},
+			},
+			"tags": []any{"x", "y"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"columns": []any{map[string]any{"name": "a"}, map[string]any{"name": "c"}},
+			"tags":    []any{},
+		}, props)
+	})
+
+	t.Run("should not share copied values", func(t *testing.T) {
+		props, err := process(t, []any{
+			map[string]any{"op": "copy", "from": "labels", "to": "tags"},
+			map[string]any{"op": "set", "path": "tags.copied", "value": "true"},
+		}, map[string]any{"labels": map[string]any{"team": "growth"}})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{"team": "growth"}, props["labels"])
+		assert.Equal(t, map[string]any{"team": "growth", "copied": "true"}, props["tags"])
+	})
+
+	t.Run("should ignore missing paths", func(t *testing.T) {
+		props, err := process(t, []any{
+			map[string]any{"op": "move", "from": "schema", "to": "columns"},
+			map[string]any{"op": "coerce", "path": "columns[*].length", "type": "int"},
+			map[string]any{"op": "delete", "path": "labels.team"},
+		}, nil)
+		require.NoError(t, err)
+		assert.Empty(t, props)
+	})
+
+	t.Run("should return error for values that cannot be coerced", func(t *testing.T) {
+		_, err := process(t, []any{
+			map[string]any{"op": "coerce", "path": "columns[*].length", "type": "int"},
+		}, map[string]any{"columns": []any{map[string]any{"length": "12.5"}}})
+		assert.EqualError(t, err, `coerce columns[*].length: cannot coerce "12.5" to int`)
+	})
+
+	t.Run("should return error for paths through other values", func(t *testing.T) {
+		_, err := process(t, []any{
+			map[string]any{"op": "set", "path": "labels.team", "value": "growth"},
+		}, map[string]any{"labels": "growth"})
+		assert.EqualError(t, err, `set labels.team: cannot set "team" on a string`)
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:       func() plugins.Processor { return mapper.New(testutils.Logger) },
+		Reachable: true,
+	}.Run(t)
+}
@@ -0,0 +1,220 @@
+package mapper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// step is a key of an object, an index of a list, or every element of a
+// list when all is set.
+type step struct {
+	key   string
+	index int
+	all   bool
+}
+
+func (s step) isKey() bool { return s.key != "" }
+
+// path is a dotted path into the entity properties, such as labels.owner,
+// columns[0].name or columns[*].data_type.
+type path []step
+
+func parsePath(s string) (path, error) {
+	if s == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	var p path
+	for _, part := range strings.Split(s, ".") {
+		key, rest, indexed := strings.Cut(part, "[")
+		if key == "" {
+			return nil, fmt.Errorf("path %q: empty key", s)
+		}
+		p = append(p, step{key: key})
+		if !indexed {
+			continue
+		}
+		for _, idx := range strings.Split("["+rest, "[")[1:] {
+			idx, ok := strings.CutSuffix(idx, "]")
+			if !ok {
+				return nil, fmt.Errorf("path %q: unclosed [", s)
+			}
+			if idx == "*" {
+				p = append(p, step{all: true})
+				continue
+			}
+			i, err := strconv.Atoi(idx)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("path %q: index %q is not * or a number", s, idx)
+			}
+			p = append(p, step{index: i})
+		}
+	}
+	return p, nil
+}
+
+func (p path) wildcards() int {
+	var n int
+	for _, s := range p {
+		if s.all {
+			n++
+		}
+	}
+	return n
+}
+
+// withIndexes replaces the wildcards of p with indexes, in order.
+func (p path) withIndexes(indexes []int) path {
+	out := make(path, len(p))
+	copy(out, p)
+	var n int
+	for i, s := range out {
+		if s.all && n < len(indexes) {
+			out[i] = step{index: indexes[n]}
+			n++
+		}
+	}
+	return out
+}
+
+// target is a path without wildcards, with the indexes the wildcards of
+// the path it was expanded from stood for.
+type target struct {
+	path    path
+	indexes []int
+}
+
+// expand returns a target for each element of the lists the wildcards of p
+// stand for. A path without wildcards expands to itself.
+func expand(root any, p path) []target {
+	var out []target
+	var walk func(node any, i int, prefix path, indexes []int)
+	walk = func(node any, i int, prefix path, indexes []int) {
+		for ; i < len(p); i++ {
+			if !p[i].all {
+				prefix = append(prefix, p[i])
+				node, _ = get(node, path{p[i]})
+				continue
+			}
+			list, _ := node.([]any)
+			for j, el := range list {
+				next := append(append(path{}, prefix...), step{index: j})
+				walk(el, i+1, next, append(append([]int{}, indexes...), j))
+			}
+			return
+		}
+		out = append(out, target{path: prefix, indexes: indexes})
+	}
+	walk(root, 0, nil, nil)
+	return out
+}
+
+// get returns the value at p, which has no wildcards.
+func get(node any, p path) (any, bool) {
+	for _, s := range p {
+		switch {
+		case s.isKey():
+			m, ok := node.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if node, ok = m[s.key]; !ok {
+				return nil, false
+			}
+		default:
+			list, ok := node.([]any)
+			if !ok || s.index >= len(list) {
+				return nil, false
+			}
+			node = list[s.index]
+		}
+	}
+	return node, true
+}
+
+// set sets the value at p, which has no wildcards, creating the objects
+// and lists on the way.
+func set(root map[string]any, p path, value any) error {
+	_, err := setIn(root, p, value)
+	return err
+}
+
+func setIn(node any, p path, value any) (any, error) {
+	if len(p) == 0 {
+		return value, nil
+	}
+
+	s := p[0]
+	if s.isKey() {
+		if node == nil {
+			node = make(map[string]any)
+		}
+		m, ok := node.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("cannot set %q on a %s", s.key, kind(node))
+		}
+		v, err := setIn(m[s.key], p[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		m[s.key] = v
+		return m, nil
+	}
+
+	if node == nil {
+		node = []any{}
+	}
+	list, ok := node.([]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot set index %d on a %s", s.index, kind(node))
+	}
+	for len(list) <= s.index {
+		list = append(list, nil)
+	}
+	v, err := setIn(list[s.index], p[1:], value)
+	if err != nil {
+		return nil, err
+	}
+	list[s.index] = v
+	return list, nil
+}
+
+// remove deletes the key or list element at p, which has no wildcards.
+func remove(root map[string]any, p path) {
+	parent, ok := get(root, p[:len(p)-1])
+	if !ok {
+		return
+	}
+
+	last := p[len(p)-1]
+	if last.isKey() {
+		if m, ok := parent.(map[string]any); ok {
+			delete(m, last.key)
+		}
+		return
+	}
+	list, ok := parent.([]any)
+	if !ok || last.index >= len(list) {
+		return
+	}
+	list = append(list[:last.index], list[last.index+1:]...)
+	// the list is shorter now, so it is set on its parent again
+	_ = set(root, p[:len(p)-1], list)
+}
+
+func kind(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "list"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "bool"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,111 @@
+package owners
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/raystack/meteor/metrics/otelhttpclient"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
+)
+
+// lookup resolves owners with an HTTP service, caching what it returns,
+// including owners it does not know.
+type lookup struct {
+	config Lookup
+	url    *template.Template
+	client *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+type cached struct {
+	urn     string
+	found   bool
+	expires time.Time
+}
+
+func newLookup(config Lookup) (*lookup, error) {
+	tmpl, err := template.New("url").Option("missingkey=error").Funcs(tmplutil.Funcs()).Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse lookup url: %w", err)
+	}
+
+	return &lookup{
+		config: config,
+		url:    tmpl,
+		client: &http.Client{
+			Timeout:   config.Timeout,
+			Transport: otelhttpclient.NewHTTPTransport(nil),
+		},
+		cache: make(map[string]cached),
+	}, nil
+}
+
+func (l *lookup) resolve(ctx context.Context, owner identity) (string, bool, error) {
+	l.mu.Lock()
+	c, ok := l.cache[owner.urn]
+	l.mu.Unlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.urn, c.found, nil
+	}
+
+	urn, found, err := l.fetch(ctx, owner)
+	if err != nil {
+		return "", false, err
+	}
+
+	l.mu.Lock()
+	l.cache[owner.urn] = cached{urn: urn, found: found, expires: time.Now().Add(l.config.CacheTTL)}
+	l.mu.Unlock()
+	return urn, found, nil
+}
+
+func (l *lookup) fetch(ctx context.Context, owner identity) (string, bool, error) {
+	var buf bytes.Buffer
+	if err := l.url.Execute(&buf, owner.data()); err != nil {
+		return "", false, fmt.Errorf("build lookup url: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, buf.String(), nil)
+	if err != nil {
+		return "", false, fmt.Errorf("create lookup request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range l.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := l.client.Do(req)
+	if err != nil {
+		return "", false, fmt.Errorf("lookup owner %q: %w", owner.urn, err)
+	}
+	defer plugins.DrainBody(res)
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return "", false, nil
+	case res.StatusCode < 200 || res.StatusCode >= 300:
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return "", false, fmt.Errorf("lookup owner %q: status %d: %s", owner.urn, res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var body any
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", false, fmt.Errorf("lookup owner %q: decode response: %w", owner.urn, err)
+	}
+	urn, ok := tmplutil.Field(body, l.config.URNField).(string)
+	if !ok || urn == "" {
+		return "", false, nil
+	}
+	return urn, true, nil
+}
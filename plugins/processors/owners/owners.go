@@ -0,0 +1,276 @@
+package owners
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mcuadros/go-defaults"
+	"github.com/raystack/meteor/internal/urnglob"
+	"github.com/raystack/meteor/models"
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	// Mapping is a YAML file of owner URNs or identities, such as emails,
+	// to the URNs they resolve to.
+	Mapping string  `mapstructure:"mapping" validate:"required_without_all=Lookup Rules"`
+	Lookup  *Lookup `mapstructure:"lookup"`
+	Rules   []Rule  `mapstructure:"rules" validate:"dive"`
+	// Unresolved is what happens to owned_by edges whose owner is not
+	// resolved.
+	Unresolved string `mapstructure:"unresolved" validate:"oneof=keep drop" default:"keep"`
+}
+
+// Lookup resolves owners with an HTTP service.
+type Lookup struct {
+	URL      string            `mapstructure:"url" validate:"required"`
+	Headers  map[string]string `mapstructure:"headers"`
+	URNField string            `mapstructure:"urn_field" default:"urn"`
+	CacheTTL time.Duration     `mapstructure:"cache_ttl" default:"1h"`
+	Timeout  time.Duration     `mapstructure:"timeout" default:"5s"`
+}
+
+// Rule adds owners to the entities of the given types whose URNs match one
+// of the patterns.
+type Rule struct {
+	Types  []string `mapstructure:"types"`
+	URNs   []string `mapstructure:"urns"`
+	Owners []string `mapstructure:"owners" validate:"required,min=1"`
+}
+
+type rule struct {
+	Rule
+	urns []*regexp.Regexp
+}
+
+// Processor resolves the owners of entities to user and team URNs.
+type Processor struct {
+	plugins.BasePlugin
+	config  Config
+	mapping map[string]string
+	lookup  *lookup
+	rules   []rule
+	logger  log.Logger
+}
+
+var sampleConfig = `
+# YAML file of owner URNs or identities to the URNs they resolve to
+mapping: ./owners.yaml
+# HTTP service to resolve owners missing from the mapping
+lookup:
+  url: 'https://directory.example.com/api/people/{{ "{{" }} .id | urlquery }}'
+  headers:
+    Authorization: Bearer token
+  urn_field: urn
+  cache_ttl: 1h
+  timeout: 5s
+# Owners added to the matching entities
+rules:
+  - types: [table]
+    urns: ["urn:bigquery:*:table:*.finance_*.*"]
+    owners: [urn:team:finance]
+# keep or drop owned_by edges whose owner is not resolved
+unresolved: keep`
+
+var info = plugins.Info{
+	Description:  "Resolve owners to user and team URNs.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	p.mapping = nil
+	if p.config.Mapping != "" {
+		if p.mapping, err = readMapping(p.config.Mapping); err != nil {
+			return err
+		}
+	}
+
+	p.lookup = nil
+	if p.config.Lookup != nil {
+		// defaults are only set on the lookup once it is decoded
+		defaults.SetDefaults(p.config.Lookup)
+		if p.lookup, err = newLookup(*p.config.Lookup); err != nil {
+			return err
+		}
+	}
+
+	p.rules = make([]rule, 0, len(p.config.Rules))
+	for _, r := range p.config.Rules {
+		compiled := rule{Rule: r}
+		for _, pattern := range r.URNs {
+			compiled.urns = append(compiled.urns, urnglob.Compile(pattern))
+		}
+		p.rules = append(p.rules, compiled)
+	}
+
+	return nil
+}
+
+// Process resolves the owners of owned_by edges and adds the owners of the
+// rules matching the entity.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+
+	var edges []*meteorv1beta1.Edge
+	owners := make(map[string]bool)
+	for _, e := range src.Edges() {
+		if e.GetType() != "owned_by" {
+			edges = append(edges, e)
+			continue
+		}
+
+		urn, ok, err := p.resolve(ctx, e.GetTargetUrn())
+		if err != nil {
+			return src, err
+		}
+		if !ok {
+			p.logger.Debug("owner not resolved", "record", entity.GetUrn(), "owner", e.GetTargetUrn())
+			if p.config.Unresolved == "drop" {
+				continue
+			}
+			urn = e.GetTargetUrn()
+		}
+		if owners[urn] {
+			continue
+		}
+		owners[urn] = true
+		e.TargetUrn = urn
+		edges = append(edges, e)
+	}
+
+	for _, r := range p.rules {
+		if !r.matches(entity) {
+			continue
+		}
+		for _, owner := range r.Owners {
+			urn, ok, err := p.resolve(ctx, owner)
+			if err != nil {
+				return src, err
+			}
+			if !ok {
+				urn = owner
+			}
+			if owners[urn] {
+				continue
+			}
+			owners[urn] = true
+			edges = append(edges, models.OwnerEdge(entity.GetUrn(), urn, entity.GetSource()))
+		}
+	}
+
+	return models.NewRecord(entity, edges...), nil
+}
+
+// resolve looks an owner up in the mapping, by URN and then by identity,
+// and then with the lookup service.
+func (p *Processor) resolve(ctx context.Context, owner string) (string, bool, error) {
+	id := parseIdentity(owner)
+	if urn, ok := p.mapping[id.urn]; ok {
+		return urn, true, nil
+	}
+	if urn, ok := p.mapping[id.id]; ok {
+		return urn, true, nil
+	}
+	if p.lookup == nil {
+		return "", false, nil
+	}
+	return p.lookup.resolve(ctx, id)
+}
+
+// identity is an owner as it arrives from extractors, such as
+// urn:dbt:scope:user:jane@example.com or urn:user:jane@example.com, with
+// the parts of its URN.
+type identity struct {
+	urn, service, scope, typ, id string
+}
+
+func parseIdentity(owner string) identity {
+	id := identity{urn: owner, id: owner}
+	parts := strings.SplitN(owner, ":", 5)
+	if parts[0] != "urn" || len(parts) < 3 {
+		return id
+	}
+	switch len(parts) {
+	case 5:
+		id.service, id.scope, id.typ, id.id = parts[1], parts[2], parts[3], parts[4]
+	default:
+		id.typ, id.id = parts[len(parts)-2], parts[len(parts)-1]
+	}
+	return id
+}
+
+func (id identity) data() map[string]string {
+	return map[string]string{
+		"urn":     id.urn,
+		"service": id.service,
+		"scope":   id.scope,
+		"type":    id.typ,
+		"id":      id.id,
+	}
+}
+
+func (r rule) matches(entity *meteorv1beta1.Entity) bool {
+	if len(r.Types) > 0 && !slices.Contains(r.Types, entity.GetType()) {
+		return false
+	}
+	if len(r.urns) == 0 {
+		return true
+	}
+	for _, re := range r.urns {
+		if re.MatchString(entity.GetUrn()) {
+			return true
+		}
+	}
+	return false
+}
+
+func readMapping(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("open mapping: %w", err)
+	}
+
+	var mapping map[string]string
+	if err := yaml.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("read mapping %q: %w", path, err)
+	}
+	return mapping, nil
+}
+
+func init() {
+	if err := registry.Processors.Register("owners", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,179 @@
+//go:build plugins
+
+package owners_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/owners"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	t.Run("should return error for empty config", func(t *testing.T) {
+		p := owners.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{}})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return error for lookup without url", func(t *testing.T) {
+		p := owners.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{
+			"lookup": map[string]any{"urn_field": "urn"},
+		}})
+		assert.ErrorContains(t, err, "is required")
+	})
+
+	t.Run("should return error for missing mapping", func(t *testing.T) {
+		p := owners.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{"mapping": "testdata/missing.yaml"}})
+		assert.ErrorContains(t, err, "open mapping")
+	})
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+
+	table := func(urn string, ownerURNs ...string) models.Record {
+		edges := []*meteorv1beta1.Edge{models.DerivedFromEdge(urn, "urn:bigquery:prod:table:raw.events", "bigquery")}
+		for _, o := range ownerURNs {
+			edges = append(edges, models.OwnerEdge(urn, o, "bigquery"))
+		}
+		return models.NewRecord(models.NewEntity(urn, "table", "orders", "bigquery", nil), edges...)
+	}
+	ownersOf := func(rec models.Record) []string {
+		var urns []string
+		for _, e := range rec.Edges() {
+			if e.GetType() == "owned_by" {
+				assert.Equal(t, rec.Entity().GetUrn(), e.GetSourceUrn())
+				urns = append(urns, e.GetTargetUrn())
+			}
+		}
+		return urns
+	}
+
+	t.Run("should resolve owners with mapping", func(t *testing.T) {
+		p := owners.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"mapping": "testdata/owners.yaml"}}))
+
+		dst, err := p.Process(ctx, table("urn:bigquery:prod:table:sales.orders",
+			"urn:dbt:prod:user:jane@example.com",
+			"urn:github:org:user:janedoe",
+			"urn:pagerduty:prod:team:PXYZ123",
+			"urn:user:unknown@example.com",
+		))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"urn:user:jane@example.com", "urn:team:payments", "urn:user:unknown@example.com"}, ownersOf(dst))
+		assert.Len(t, dst.Edges(), 4, "derived_from edge is kept")
+	})
+
+	t.Run("should drop unresolved owners", func(t *testing.T) {
+		p := owners.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"mapping":    "testdata/owners.yaml",
+			"unresolved": "drop",
+		}}))
+
+		dst, err := p.Process(ctx, table("urn:bigquery:prod:table:sales.orders", "janedoe", "urn:user:unknown@example.com"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"urn:user:jane@example.com"}, ownersOf(dst))
+	})
+
+	t.Run("should resolve owners with lookup and cache them", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			switch id := strings.TrimPrefix(r.URL.Path, "/people/"); id {
+			case "john@example.com":
+				require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"urn": "urn:user:john@example.com"}}))
+			case "PABC":
+				require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"urn": "urn:team:growth"}}))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer srv.Close()
+
+		p := owners.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"mapping": "testdata/owners.yaml",
+			"lookup": map[string]any{
+				"url":       srv.URL + "/people/{{ .id | urlquery }}",
+				"headers":   map[string]any{"Authorization": "Bearer token"},
+				"urn_field": "data.urn",
+			},
+		}}))
+
+		for i := 0; i < 2; i++ {
+			dst, err := p.Process(ctx, table("urn:bigquery:prod:table:sales.orders",
+				"urn:dbt:prod:user:john@example.com",
+				"urn:pagerduty:prod:team:PABC",
+				"urn:dbt:prod:user:jane@example.com",
+				"urn:dbt:prod:user:nobody",
+			))
+			require.NoError(t, err)
+			assert.Equal(t, []string{"urn:user:john@example.com", "urn:team:growth", "urn:user:jane@example.com", "urn:dbt:prod:user:nobody"}, ownersOf(dst))
+		}
+		assert.EqualValues(t, 3, requests.Load(), "owners in the mapping are not looked up, the others are cached")
+	})
+
+	t.Run("should return error for failing lookup", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "directory unavailable", http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		p := owners.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"lookup": map[string]any{"url": srv.URL + "/{{ .id }}"},
+		}}))
+
+		_, err := p.Process(ctx, table("urn:bigquery:prod:table:sales.orders", "urn:user:jane@example.com"))
+		assert.EqualError(t, err, `lookup owner "urn:user:jane@example.com": status 503: directory unavailable`)
+	})
+
+	t.Run("should add owners of matching rules", func(t *testing.T) {
+		p := owners.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"mapping": "testdata/owners.yaml",
+			"rules": []any{
+				map[string]any{
+					"types":  []any{"table"},
+					"urns":   []any{"urn:bigquery:*:table:finance_*.*"},
+					"owners": []any{"urn:team:finance", "jane@example.com"},
+				},
+				map[string]any{"types": []any{"dashboard"}, "owners": []any{"urn:team:bi"}},
+			},
+		}}))
+
+		dst, err := p.Process(ctx, table("urn:bigquery:prod:table:finance_ledger.entries", "urn:dbt:prod:user:jane@example.com"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"urn:user:jane@example.com", "urn:team:finance"}, ownersOf(dst))
+
+		dst, err = p.Process(ctx, table("urn:bigquery:prod:table:sales.orders"))
+		require.NoError(t, err)
+		assert.Empty(t, ownersOf(dst))
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:       func() plugins.Processor { return owners.New(testutils.Logger) },
+		Config:    plugins.Config{RawConfig: map[string]any{"mapping": "testdata/owners.yaml"}},
+		Reachable: true,
+	}.Run(t)
+}
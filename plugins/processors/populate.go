@@ -1,7 +1,16 @@
 package processors
 
 import (
+	_ "github.com/raystack/meteor/plugins/processors/classify"
+	_ "github.com/raystack/meteor/plugins/processors/docs"
 	_ "github.com/raystack/meteor/plugins/processors/enrich"
+	_ "github.com/raystack/meteor/plugins/processors/httpenrich"
 	_ "github.com/raystack/meteor/plugins/processors/labels"
+	_ "github.com/raystack/meteor/plugins/processors/mapper"
+	_ "github.com/raystack/meteor/plugins/processors/owners"
+	_ "github.com/raystack/meteor/plugins/processors/redact"
+	_ "github.com/raystack/meteor/plugins/processors/schemadiff"
 	_ "github.com/raystack/meteor/plugins/processors/script"
+	_ "github.com/raystack/meteor/plugins/processors/sqllineage"
+	_ "github.com/raystack/meteor/plugins/processors/urnrewrite"
 )
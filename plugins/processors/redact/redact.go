@@ -0,0 +1,223 @@
+package redact
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"regexp"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	// Detectors limits the built-in detectors to run, all of them by default.
+	Detectors []string `mapstructure:"detectors" validate:"dive,oneof=url_credentials connection_string bearer_token aws_key private_key high_entropy secret_key"`
+	// Keys are regular expressions matching the names of properties whose
+	// values are secrets, case insensitive.
+	Keys   []string `mapstructure:"keys" validate:"dive,regexp"`
+	Action string   `mapstructure:"action" validate:"oneof=mask remove" default:"mask"`
+	// Replacement is the text secrets are masked with.
+	Replacement string `mapstructure:"replacement" default:"[REDACTED]"`
+	// MinEntropy is the bits per character from which the high_entropy
+	// detector takes a string for a secret.
+	MinEntropy float64 `mapstructure:"min_entropy" validate:"gt=0" default:"4.5"`
+}
+
+// Processor masks or removes secrets in the properties of entities and
+// edges.
+type Processor struct {
+	plugins.BasePlugin
+	config     Config
+	detectors  []detector
+	secretKeys bool
+	keys       []*regexp.Regexp
+	logger     log.Logger
+}
+
+var sampleConfig = `
+# Built-in detectors to run, all of them by default
+detectors: [url_credentials, connection_string, bearer_token, aws_key, private_key, high_entropy, secret_key]
+# Names of properties holding secrets, as regular expressions
+keys:
+  - "^db_conn$"
+  - "_salt$"
+# mask or remove secrets
+action: mask
+replacement: "[REDACTED]"
+# Bits per character from which a string is a secret for high_entropy
+min_entropy: 4.5`
+
+var info = plugins.Info{
+	Description:  "Mask or remove credentials in properties.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	enabled := make(map[string]bool)
+	for _, name := range p.config.Detectors {
+		enabled[name] = true
+	}
+	p.detectors = nil
+	for _, d := range builtins {
+		if len(enabled) > 0 && !enabled[d.name] {
+			continue
+		}
+		if d.name == "high_entropy" {
+			d.check = entropyAbove(p.config.MinEntropy)
+		}
+		p.detectors = append(p.detectors, d)
+	}
+	p.secretKeys = len(enabled) == 0 || enabled["secret_key"]
+
+	p.keys = nil
+	for _, k := range p.config.Keys {
+		p.keys = append(p.keys, regexp.MustCompile("(?i)"+k))
+	}
+
+	return nil
+}
+
+// Process redacts the secrets in the properties of the entity and its
+// edges.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+
+	var redacted int
+	if entity.GetProperties() != nil {
+		props := entity.GetProperties().AsMap()
+		if n := p.object(props); n > 0 {
+			if entity.Properties, err = structpb.NewStruct(props); err != nil {
+				return src, fmt.Errorf("set properties: %w", err)
+			}
+			redacted += n
+		}
+	}
+	for _, e := range src.Edges() {
+		if e.GetProperties() == nil {
+			continue
+		}
+		props := e.GetProperties().AsMap()
+		if n := p.object(props); n > 0 {
+			if e.Properties, err = structpb.NewStruct(props); err != nil {
+				return src, fmt.Errorf("set properties of edge %s: %w", e.GetTargetUrn(), err)
+			}
+			redacted += n
+		}
+	}
+	if redacted == 0 {
+		return src, nil
+	}
+	p.logger.Debug("redacted secrets", "record", entity.GetUrn(), "secrets", redacted)
+
+	return models.NewRecord(entity, src.Edges()...), nil
+}
+
+// object redacts the values of m in place, and returns the number of
+// secrets found. The value of a map with name and value keys, such as an
+// environment variable of a container, is a secret when its name is.
+func (p *Processor) object(m map[string]any) int {
+	name, _ := m["name"].(string)
+	secretValue := name != "" && p.isSecret(name)
+
+	var n int
+	for k, v := range m {
+		if p.isSecret(k) || (secretValue && k == "value") {
+			if v == nil || v == "" || v == p.config.Replacement {
+				continue
+			}
+			n++
+			if p.config.Action == "remove" {
+				delete(m, k)
+			} else {
+				m[k] = p.config.Replacement
+			}
+			continue
+		}
+
+		out, found, drop := p.value(v)
+		n += found
+		if drop {
+			delete(m, k)
+		} else {
+			m[k] = out
+		}
+	}
+	return n
+}
+
+// value redacts v and returns it with the number of secrets found. drop is
+// set when the value holds a secret that is to be removed.
+func (p *Processor) value(v any) (out any, n int, drop bool) {
+	switch v := v.(type) {
+	case string:
+		s := v
+		for _, d := range p.detectors {
+			var found int
+			s, found = d.redact(s, p.config.Replacement)
+			n += found
+		}
+		if n > 0 && p.config.Action == "remove" {
+			return nil, n, true
+		}
+		return s, n, false
+
+	case map[string]any:
+		return v, p.object(v), false
+
+	case []any:
+		list := v[:0]
+		for _, item := range v {
+			out, found, drop := p.value(item)
+			n += found
+			if !drop {
+				list = append(list, out)
+			}
+		}
+		return list, n, false
+	}
+	return v, 0, false
+}
+
+func (p *Processor) isSecret(key string) bool {
+	if p.secretKeys && isSecretKey(key) {
+		return true
+	}
+	for _, re := range p.keys {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	if err := registry.Processors.Register("redact", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,206 @@
+package schemadiff
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	// State is the JSON file keeping the columns of each URN between runs.
+	State string `mapstructure:"state" validate:"required"`
+	// History is the number of latest changes kept in schema_changes.
+	History int `mapstructure:"history" validate:"min=1" default:"20"`
+	// Events sends a schema_change record to the sinks for each entity whose
+	// columns changed.
+	Events bool `mapstructure:"events"`
+}
+
+// Processor detects added and removed columns and changed column types
+// since the previous run.
+type Processor struct {
+	plugins.BasePlugin
+	config Config
+	logger log.Logger
+	emit   plugins.Emit
+
+	mu      sync.Mutex
+	schemas map[string]schema
+	changed bool
+}
+
+var sampleConfig = `
+# JSON file keeping the columns of each URN between runs
+state: ./schema_diff.json
+# Number of latest changes kept in the schema_changes property
+history: 20
+# Send a schema_change record to the sinks for entities whose columns changed
+events: true`
+
+var info = plugins.Info{
+	Description:  "Detect changes to the columns of entities between runs.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// SetEmit sets the function schema_change records are sent with.
+func (p *Processor) SetEmit(emit plugins.Emit) {
+	p.emit = emit
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	if p.schemas, err = readState(p.config.State); err != nil {
+		return err
+	}
+	p.changed = false
+
+	return nil
+}
+
+// Process compares the columns of the entity with the ones of the previous
+// run, and sets its schema_changes property to the latest changes.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+	props := entity.GetProperties().AsMap()
+	list, ok := props["columns"].([]any)
+	if !ok {
+		return src, nil
+	}
+	columns := make(map[string]string)
+	flatten(list, "", columns)
+
+	p.mu.Lock()
+	prev, seen := p.schemas[entity.GetUrn()]
+	var changes []change
+	if seen {
+		changes = diff(prev.Columns, columns, time.Now())
+	}
+	history := slices.Concat(prev.Changes, changes)
+	if len(history) > p.config.History {
+		history = history[len(history)-p.config.History:]
+	}
+	if !seen || len(changes) > 0 {
+		p.schemas[entity.GetUrn()] = schema{Columns: columns, Changes: history}
+		p.changed = true
+	}
+	p.mu.Unlock()
+
+	if len(history) == 0 {
+		return src, nil
+	}
+	if len(changes) > 0 {
+		p.logger.Info("schema changed", "record", entity.GetUrn(), "changes", len(changes))
+		if p.config.Events && p.emit != nil {
+			p.emit(changeEvent(entity.GetUrn(), entity.GetType(), entity.GetName(), entity.GetSource(), changes))
+		}
+	}
+
+	props["schema_changes"] = changeList(history)
+	if entity.Properties, err = structpb.NewStruct(props); err != nil {
+		return src, fmt.Errorf("set properties: %w", err)
+	}
+
+	return models.NewRecord(entity, src.Edges()...), nil
+}
+
+// Commit writes the columns seen in the run to the state file. It is called
+// once the run succeeded, so that changes seen in dry runs or in runs that
+// failed are reported again.
+func (p *Processor) Commit() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.changed {
+		return nil
+	}
+	if err := writeState(p.config.State, p.schemas); err != nil {
+		return err
+	}
+	p.changed = false
+	return nil
+}
+
+// flatten adds the data types of the columns, and of their nested columns
+// by dotted path, to columns.
+func flatten(list []any, prefix string, columns map[string]string) {
+	for _, c := range list {
+		col, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := col["name"].(string)
+		if name == "" {
+			continue
+		}
+		typ, _ := col["data_type"].(string)
+		columns[prefix+name] = typ
+		if nested, ok := col["columns"].([]any); ok {
+			flatten(nested, prefix+name+".", columns)
+		}
+	}
+}
+
+// changeEvent is a schema_change record referencing the entity whose
+// columns changed.
+func changeEvent(urn, typ, name, source string, changes []change) models.Record {
+	service, scope, _, id, ok := tmplutil.SplitURN(urn)
+	if !ok {
+		service, scope, id = "meteor", "", urn
+	}
+	at := changes[0].DetectedAt
+	eventURN := models.NewURN(service, scope, "schema_change", id+"@"+strconv.FormatInt(at.UnixMilli(), 10))
+
+	event := models.NewEntity(eventURN, "schema_change", name, source, map[string]any{
+		"urn":         urn,
+		"type":        typ,
+		"changes":     changeList(changes),
+		"detected_at": at.UTC().Format(time.RFC3339),
+	})
+	return models.NewRecord(event, models.ReferencesEdge(eventURN, urn, source))
+}
+
+func changeList(changes []change) []any {
+	list := make([]any, 0, len(changes))
+	for _, c := range changes {
+		list = append(list, c.toMap())
+	}
+	return list
+}
+
+func init() {
+	if err := registry.Processors.Register("schema_diff", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,155 @@
+//go:build plugins
+
+package schemadiff_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/schemadiff"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	t.Run("should return error for invalid state", func(t *testing.T) {
+		state := filepath.Join(t.TempDir(), "state.json")
+		require.NoError(t, os.WriteFile(state, []byte("{"), 0o644))
+
+		p := schemadiff.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{"state": state}})
+		assert.ErrorContains(t, err, "read state")
+	})
+
+	t.Run("should return error for invalid history", func(t *testing.T) {
+		p := schemadiff.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{"state": "state.json", "history": -1}})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+	urn := "urn:bigquery:prod:table:shop:sales.orders"
+	table := func(columns ...any) models.Record {
+		return models.NewRecord(
+			models.NewEntity(urn, "table", "orders", "bigquery", map[string]any{"columns": columns}),
+			models.OwnerEdge(urn, "urn:user:jane@example.com", "bigquery"),
+		)
+	}
+	column := func(name, typ string, nested ...any) map[string]any {
+		col := map[string]any{"name": name, "data_type": typ}
+		if len(nested) > 0 {
+			col["columns"] = nested
+		}
+		return col
+	}
+	run := func(t *testing.T, config map[string]any, records ...models.Record) ([]models.Record, []models.Record) {
+		t.Helper()
+		p := schemadiff.New(testutils.Logger)
+		var events []models.Record
+		p.SetEmit(func(rec models.Record) { events = append(events, rec) })
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: config}))
+
+		var processed []models.Record
+		for _, rec := range records {
+			dst, err := p.Process(ctx, rec)
+			require.NoError(t, err)
+			processed = append(processed, dst)
+		}
+		require.NoError(t, p.Commit())
+		return processed, events
+	}
+
+	t.Run("should detect changes to columns between runs", func(t *testing.T) {
+		config := map[string]any{"state": filepath.Join(t.TempDir(), "schemas", "state.json"), "events": true}
+
+		processed, events := run(t, config, table(
+			column("id", "INT64"),
+			column("amount", "INT64"),
+			column("note", "STRING"),
+			column("shipping", "RECORD", column("city", "STRING")),
+		))
+		assert.NotContains(t, processed[0].Entity().GetProperties().AsMap(), "schema_changes", "the first run sets the baseline")
+		assert.Empty(t, events)
+
+		start := time.Now().Add(-time.Second)
+		processed, events = run(t, config, table(
+			column("id", "INT64"),
+			column("amount", "NUMERIC"),
+			column("shipping", "RECORD", column("city", "STRING"), column("zip", "STRING")),
+			column("status", "STRING"),
+		))
+
+		changes := processed[0].Entity().GetProperties().AsMap()["schema_changes"].([]any)
+		for _, c := range changes {
+			at, err := time.Parse(time.RFC3339, c.(map[string]any)["detected_at"].(string))
+			require.NoError(t, err)
+			assert.True(t, at.After(start))
+			delete(c.(map[string]any), "detected_at")
+		}
+		assert.Equal(t, []any{
+			map[string]any{"change": "type_changed", "column": "amount", "data_type": "NUMERIC", "previous_data_type": "INT64"},
+			map[string]any{"change": "removed", "column": "note", "previous_data_type": "STRING"},
+			map[string]any{"change": "added", "column": "shipping.zip", "data_type": "STRING"},
+			map[string]any{"change": "added", "column": "status", "data_type": "STRING"},
+		}, changes)
+		assert.Len(t, processed[0].Edges(), 1)
+
+		require.Len(t, events, 1)
+		event := events[0].Entity()
+		assert.Regexp(t, `^urn:bigquery:prod:schema_change:shop:sales\.orders@\d+$`, event.GetUrn())
+		assert.Equal(t, "schema_change", event.GetType())
+		assert.Equal(t, urn, event.GetProperties().AsMap()["urn"])
+		assert.Len(t, event.GetProperties().AsMap()["changes"], 4)
+		assert.Equal(t, "references", events[0].Edges()[0].GetType())
+		assert.Equal(t, urn, events[0].Edges()[0].GetTargetUrn())
+
+		processed, events = run(t, config, table(
+			column("id", "INT64"),
+			column("amount", "NUMERIC"),
+			column("shipping", "RECORD", column("city", "STRING"), column("zip", "STRING")),
+			column("status", "STRING"),
+		))
+		assert.Len(t, processed[0].Entity().GetProperties().AsMap()["schema_changes"], 4, "earlier changes are kept")
+		assert.Empty(t, events)
+	})
+
+	t.Run("should keep the latest changes", func(t *testing.T) {
+		config := map[string]any{"state": filepath.Join(t.TempDir(), "state.json"), "history": 2}
+
+		processed, events := run(t, config,
+			table(column("a", "STRING")),
+			table(column("a", "STRING"), column("b", "STRING")),
+			table(column("a", "STRING"), column("b", "STRING"), column("c", "STRING")),
+			table(column("b", "STRING"), column("c", "STRING")),
+		)
+		var columns []any
+		for _, c := range processed[3].Entity().GetProperties().AsMap()["schema_changes"].([]any) {
+			columns = append(columns, c.(map[string]any)["column"])
+		}
+		assert.Equal(t, []any{"c", "a"}, columns)
+		assert.Empty(t, events, "events are off by default")
+	})
+
+	t.Run("should pass through entities without columns", func(t *testing.T) {
+		src := models.NewRecord(models.NewEntity("urn:metabase:prod:dashboard:sales", "dashboard", "sales", "metabase", nil))
+		processed, _ := run(t, map[string]any{"state": filepath.Join(t.TempDir(), "state.json")}, src)
+		assert.Equal(t, src, processed[0])
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:       func() plugins.Processor { return schemadiff.New(testutils.Logger) },
+		Config:    plugins.Config{RawConfig: map[string]any{"state": filepath.Join(t.TempDir(), "state.json")}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -0,0 +1,115 @@
+package schemadiff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// schema is the last seen columns of an entity and the changes to them.
+type schema struct {
+	// Columns maps the dotted paths of columns to their data types.
+	Columns map[string]string `json:"columns"`
+	Changes []change          `json:"changes,omitempty"`
+}
+
+type change struct {
+	// Change is added, removed or type_changed.
+	Change           string    `json:"change"`
+	Column           string    `json:"column"`
+	DataType         string    `json:"data_type,omitempty"`
+	PreviousDataType string    `json:"previous_data_type,omitempty"`
+	DetectedAt       time.Time `json:"detected_at"`
+}
+
+func (c change) toMap() map[string]any {
+	m := map[string]any{
+		"change":      c.Change,
+		"column":      c.Column,
+		"detected_at": c.DetectedAt.UTC().Format(time.RFC3339),
+	}
+	if c.DataType != "" {
+		m["data_type"] = c.DataType
+	}
+	if c.PreviousDataType != "" {
+		m["previous_data_type"] = c.PreviousDataType
+	}
+	return m
+}
+
+// diff returns the changes from the columns before to the columns after,
+// ordered by column.
+func diff(before, after map[string]string, at time.Time) []change {
+	var changes []change
+	for col, typ := range after {
+		prev, ok := before[col]
+		switch {
+		case !ok:
+			changes = append(changes, change{Change: "added", Column: col, DataType: typ, DetectedAt: at})
+		case prev != typ:
+			changes = append(changes, change{Change: "type_changed", Column: col, DataType: typ, PreviousDataType: prev, DetectedAt: at})
+		}
+	}
+	for col, typ := range before {
+		if _, ok := after[col]; !ok {
+			changes = append(changes, change{Change: "removed", Column: col, PreviousDataType: typ, DetectedAt: at})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Column < changes[j].Column
+	})
+	return changes
+}
+
+// readState reads the schemas by URN from a state file, which does not
+// exist before the first run.
+func readState(path string) (map[string]schema, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return make(map[string]schema), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open state: %w", err)
+	}
+
+	state := make(map[string]schema)
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("read state %q: %w", path, err)
+	}
+	return state, nil
+}
+
+// writeState replaces the state file, through a temporary file so that an
+// interrupted write does not lose the previous state.
+func writeState(path string, state map[string]schema) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode state: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/raystack/meteor/models"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -261,3 +262,11 @@ func TestProcess(t *testing.T) {
 		}
 	})
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New:       func() plugins.Processor { return New(testutils.Logger) },
+		Config:    plugins.Config{RawConfig: map[string]any{"engine": "tengo", "script": `entity.description = "processed"`}},
+		Reachable: true,
+	}.Run(t)
+}
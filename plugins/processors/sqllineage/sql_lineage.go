@@ -0,0 +1,190 @@
+package sqllineage
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	// Property is the dotted path to the SQL in the entity properties.
+	Property string `mapstructure:"property" validate:"required" default:"sql"`
+	Dialect  string `mapstructure:"dialect" validate:"oneof=ansi bigquery postgres snowflake mysql" default:"ansi"`
+	// URN is a Go template of the URN of a table the SQL reads from.
+	URN string `mapstructure:"urn" validate:"required"`
+	// DefaultDatabase and DefaultSchema are used for tables that are not
+	// qualified with them.
+	DefaultDatabase string `mapstructure:"default_database"`
+	DefaultSchema   string `mapstructure:"default_schema"`
+}
+
+// Processor adds derived_from edges to the tables the SQL of an entity reads
+// from.
+type Processor struct {
+	plugins.BasePlugin
+	config  Config
+	dialect dialect
+	urn     *template.Template
+	logger  log.Logger
+}
+
+var sampleConfig = `
+# Dotted path to the SQL in entity.properties
+property: sql
+# One of ansi, bigquery, postgres, snowflake or mysql
+dialect: postgres
+# URN of the tables the SQL reads from, with .database, .schema, .table,
+# .name, and the .service and .scope of the entity
+urn: 'urn:postgres:{{ "{{" }} .scope }}:table:{{ "{{" }} .database }}.{{ "{{" }} .table }}'
+# Used for tables that are not qualified with a database or schema
+default_database: analytics
+default_schema: public`
+
+var info = plugins.Info{
+	Description:  "Add lineage from the SQL of entities.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	p.dialect = dialects[p.config.Dialect]
+	p.urn, err = template.New("urn").Option("missingkey=error").Funcs(tmplutil.Funcs()).Parse(p.config.URN)
+	if err != nil {
+		return fmt.Errorf("parse urn template: %w", err)
+	}
+
+	return nil
+}
+
+// Process appends a derived_from edge for each table the SQL of the entity
+// reads from.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+	queries := p.queries(entity.GetProperties().AsMap())
+	if len(queries) == 0 {
+		return src, nil
+	}
+
+	known := map[string]bool{entity.GetUrn(): true}
+	for _, e := range src.Edges() {
+		if e.GetType() == "derived_from" && e.GetSourceUrn() == entity.GetUrn() {
+			known[e.GetTargetUrn()] = true
+		}
+	}
+
+	edges := slices.Clone(src.Edges())
+	service, scope, _, _, _ := tmplutil.SplitURN(entity.GetUrn())
+	for _, query := range queries {
+		for _, parts := range p.dialect.tableReferences(query) {
+			urn, err := p.tableURN(parts, service, scope)
+			if err != nil {
+				p.logger.Warn("skipping table of sql lineage", "record", entity.GetUrn(), "table", strings.Join(parts, "."), "error", err)
+				continue
+			}
+			if known[urn] {
+				continue
+			}
+			known[urn] = true
+			edges = append(edges, models.DerivedFromEdge(entity.GetUrn(), urn, entity.GetSource()))
+		}
+	}
+	p.logger.Debug("added sql lineage", "record", entity.GetUrn(), "edges", len(edges)-len(src.Edges()))
+
+	return models.NewRecord(entity, edges...), nil
+}
+
+// queries returns the SQL at the configured property, a string or a list of
+// strings.
+func (p *Processor) queries(props map[string]any) []string {
+	var v any = props
+	for _, key := range strings.Split(p.config.Property, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+
+	switch v := v.(type) {
+	case string:
+		if strings.TrimSpace(v) != "" {
+			return []string{v}
+		}
+	case []any:
+		var queries []string
+		for _, q := range v {
+			if s, ok := q.(string); ok && strings.TrimSpace(s) != "" {
+				queries = append(queries, s)
+			}
+		}
+		return queries
+	}
+	return nil
+}
+
+func (p *Processor) tableURN(parts []string, service, scope string) (string, error) {
+	data := map[string]string{
+		"name":    strings.Join(parts, "."),
+		"table":   parts[len(parts)-1],
+		"service": service,
+		"scope":   scope,
+	}
+	if schema := part(parts, 2, p.config.DefaultSchema); schema != "" {
+		data["schema"], data["dataset"] = schema, schema
+	}
+	if database := part(parts, 3, p.config.DefaultDatabase); database != "" {
+		data["database"], data["project"] = database, database
+	}
+
+	var buf bytes.Buffer
+	if err := p.urn.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// part returns the n-th part from the end of a qualified name, or def when
+// the name has fewer parts.
+func part(parts []string, n int, def string) string {
+	if len(parts) >= n {
+		return parts[len(parts)-n]
+	}
+	return def
+}
+
+func init() {
+	if err := registry.Processors.Register("sql_lineage", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,191 @@
+//go:build plugins
+
+package sqllineage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/sqllineage"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	t.Run("should return error for unknown dialect", func(t *testing.T) {
+		p := sqllineage.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{
+			"dialect": "oracle",
+			"urn":     "urn:oracle:{{ .scope }}:table:{{ .name }}",
+		}})
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return error for invalid urn template", func(t *testing.T) {
+		p := sqllineage.New(testutils.Logger)
+		err := p.Init(context.Background(), plugins.Config{RawConfig: map[string]any{"urn": "urn:{{ .name"}})
+		assert.ErrorContains(t, err, "parse urn template")
+	})
+}
+
+func TestProcess(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]any
+		sql      any
+		expected []string
+	}{
+		{
+			name: "postgres view with joins, subqueries and ctes",
+			config: map[string]any{
+				"dialect":          "postgres",
+				"urn":              "urn:postgres:{{ .scope }}:table:{{ .database }}.{{ .schema }}.{{ .table }}",
+				"default_database": "shop",
+				"default_schema":   "public",
+			},
+			sql: `
+				WITH recent AS (
+					SELECT * FROM Orders WHERE created_at > now() - interval '7 days' -- FROM ignored
+				), totals (customer_id, total) AS (
+					SELECT customer_id, sum(amount)::numeric FROM recent GROUP BY 1
+				)
+				SELECT c.name, t.total, EXTRACT(YEAR FROM c.created_at)
+				FROM totals t
+				JOIN "Sales"."Customers" c ON c.id = t.customer_id
+				LEFT JOIN LATERAL (SELECT * FROM billing.invoices i WHERE i.customer_id = c.id) inv ON true
+				WHERE c.id IN (SELECT customer_id FROM shop.crm.blocked) /* FROM comment */
+				  AND c.note <> 'FROM quoted'
+				  AND c.email IS DISTINCT FROM $$ FROM dollar $$`,
+			expected: []string{
+				"urn:postgres:prod:table:shop.public.orders",
+				"urn:postgres:prod:table:shop.Sales.Customers",
+				"urn:postgres:prod:table:shop.billing.invoices",
+				"urn:postgres:prod:table:shop.crm.blocked",
+			},
+		},
+		{
+			name: "bigquery with backticks, dashes and table functions",
+			config: map[string]any{
+				"dialect": "bigquery",
+				"urn":     "urn:bigquery:{{ .project }}:table:{{ .project }}:{{ .dataset }}.{{ .table }}",
+			},
+			sql: "SELECT o.id, item FROM `my-project.sales.orders` o, UNNEST(o.items) AS item " +
+				"JOIN my-project.sales.products p USING (sku) " +
+				"WHERE o.note != \"FROM string\" AND o.id IN (SELECT id FROM `other-project`.ops.refunds)",
+			expected: []string{
+				"urn:bigquery:my-project:table:my-project:sales.orders",
+				"urn:bigquery:my-project:table:my-project:sales.products",
+				"urn:bigquery:other-project:table:other-project:ops.refunds",
+			},
+		},
+		{
+			name: "snowflake folds unquoted names to upper case",
+			config: map[string]any{
+				"dialect":          "snowflake",
+				"urn":              "urn:snowflake:{{ .scope }}:table:{{ .database }}.{{ .table }}",
+				"default_database": "ANALYTICS",
+			},
+			sql:      `select * from raw.events e, "raw"."users" u where e.user_id = u.id`,
+			expected: []string{"urn:snowflake:prod:table:ANALYTICS.EVENTS", "urn:snowflake:prod:table:ANALYTICS.users"},
+		},
+		{
+			name: "template functions",
+			config: map[string]any{
+				"dialect":          "snowflake",
+				"urn":              "urn:snowflake:{{ .scope }}:table:{{ .database | lower }}.{{ .table | lower }}",
+				"default_database": "ANALYTICS",
+			},
+			sql:      `select * from raw.events`,
+			expected: []string{"urn:snowflake:prod:table:analytics.events"},
+		},
+		{
+			name: "mysql with hash comments and a list of queries",
+			config: map[string]any{
+				"dialect":          "mysql",
+				"urn":              "urn:mysql:{{ .scope }}:table:{{ .database }}.{{ .table }}",
+				"default_database": "shop",
+			},
+			sql: []any{
+				"SELECT * FROM `orders` # FROM ignored\nSTRAIGHT_JOIN shop.customers ON 1 = 1",
+				"SELECT * FROM shop.customers",
+			},
+			expected: []string{"urn:mysql:prod:table:shop.orders", "urn:mysql:prod:table:shop.customers"},
+		},
+		{
+			name:     "ansi merge using source",
+			config:   map[string]any{"urn": "urn:postgres:{{ .scope }}:table:{{ .name }}"},
+			sql:      `MERGE INTO target t USING staging.updates s ON t.id = s.id WHEN MATCHED THEN UPDATE SET v = s.v`,
+			expected: []string{"urn:postgres:prod:table:staging.updates"},
+		},
+		{
+			name:     "tables missing parts of the urn are skipped",
+			config:   map[string]any{"urn": "urn:postgres:{{ .scope }}:table:{{ .database }}.{{ .table }}"},
+			sql:      `SELECT * FROM orders JOIN shop.public.customers USING (id)`,
+			expected: []string{"urn:postgres:prod:table:shop.customers"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run("should add lineage of "+tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			tc.config["property"] = "definition.sql"
+			p := sqllineage.New(testutils.Logger)
+			require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: tc.config}))
+
+			urn := "urn:postgres:prod:view:shop.report"
+			src := models.NewRecord(
+				models.NewEntity(urn, "view", "report", "postgres", map[string]any{
+					"definition": map[string]any{"sql": tc.sql},
+				}),
+				models.OwnerEdge(urn, "urn:user:jane@example.com", "postgres"),
+			)
+			dst, err := p.Process(ctx, src)
+			require.NoError(t, err)
+
+			var upstreams []string
+			for _, e := range dst.Edges()[1:] {
+				assert.Equal(t, "derived_from", e.GetType())
+				assert.Equal(t, urn, e.GetSourceUrn())
+				assert.Equal(t, "postgres", e.GetSource())
+				upstreams = append(upstreams, e.GetTargetUrn())
+			}
+			assert.Equal(t, tc.expected, upstreams)
+			assert.Equal(t, "owned_by", dst.Edges()[0].GetType())
+		})
+	}
+
+	t.Run("should skip known upstreams and entities without sql", func(t *testing.T) {
+		ctx := context.Background()
+		p := sqllineage.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{"urn": "urn:pg:{{ .scope }}:table:{{ .name }}"}}))
+
+		urn := "urn:pg:prod:table:report"
+		src := models.NewRecord(
+			models.NewEntity(urn, "view", "report", "pg", map[string]any{"sql": "SELECT * FROM report, orders"}),
+			models.DerivedFromEdge(urn, "urn:pg:prod:table:orders", "pg"),
+		)
+		dst, err := p.Process(ctx, src)
+		require.NoError(t, err)
+		assert.Len(t, dst.Edges(), 1)
+
+		src = models.NewRecord(models.NewEntity("urn:pg:prod:table:orders", "table", "orders", "pg", nil))
+		dst, err = p.Process(ctx, src)
+		require.NoError(t, err)
+		assert.Equal(t, src, dst)
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New: func() plugins.Processor { return sqllineage.New(testutils.Logger) },
+		Config: plugins.Config{RawConfig: map[string]any{
+			"property": "sql",
+			"urn":      "urn:postgres:{{ .scope }}:table:{{ .database }}.{{ .table }}",
+		}},
+		Reachable: true,
+	}.Run(t)
+}
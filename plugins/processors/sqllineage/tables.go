@@ -0,0 +1,342 @@
+package sqllineage
+
+import (
+	"strings"
+	"unicode"
+)
+
+// dialect holds the lexical rules of a SQL dialect that matter for finding
+// the tables a query reads from.
+type dialect struct {
+	// quote is the character quoting identifiers.
+	quote byte
+	// stringQuotes are the characters quoting strings.
+	stringQuotes string
+	// fold is applied to unquoted identifiers.
+	fold func(string) string
+	// backslashEscapes is set when backslashes escape quotes in strings.
+	backslashEscapes bool
+	// hashComments is set when # starts a line comment.
+	hashComments bool
+	// dollarQuotes is set for $$ and $tag$ quoted strings.
+	dollarQuotes bool
+	// pathIdentifiers is set when a quoted identifier can hold a dotted
+	// path, and unquoted identifiers can hold dashes, as in BigQuery.
+	pathIdentifiers bool
+}
+
+var dialects = map[string]dialect{
+	"ansi": {quote: '"', stringQuotes: "'"},
+	"bigquery": {
+		quote: '`', stringQuotes: `'"`, backslashEscapes: true, pathIdentifiers: true,
+	},
+	"postgres": {
+		quote: '"', stringQuotes: "'", fold: strings.ToLower, dollarQuotes: true,
+	},
+	"snowflake": {
+		quote: '"', stringQuotes: "'", fold: strings.ToUpper, backslashEscapes: true, dollarQuotes: true,
+	},
+	"mysql": {
+		quote: '`', stringQuotes: `'"`, backslashEscapes: true, hashComments: true,
+	},
+}
+
+type tokenKind int
+
+const (
+	identToken tokenKind = iota
+	quotedToken
+	symbolToken
+	literalToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// is reports whether t is the unquoted keyword kw.
+func (t token) is(kw string) bool {
+	return t.kind == identToken && strings.EqualFold(t.text, kw)
+}
+
+func (t token) isSymbol(s string) bool {
+	return t.kind == symbolToken && t.text == s
+}
+
+func (t token) isName() bool {
+	return t.kind == identToken || t.kind == quotedToken
+}
+
+// tokenize splits a query into identifiers, symbols and literals, dropping
+// comments and whitespace.
+func (d dialect) tokenize(sql string) []token {
+	var tokens []token
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case strings.HasPrefix(sql[i:], "--") || (d.hashComments && c == '#'):
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+
+		case strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return tokens
+			}
+			i += end + 4
+
+		case c == d.quote:
+			text, n := d.quoted(sql[i:], c, false)
+			tokens = append(tokens, token{kind: quotedToken, text: text})
+			i += n
+
+		case strings.IndexByte(d.stringQuotes, c) >= 0:
+			_, n := d.quoted(sql[i:], c, d.backslashEscapes)
+			tokens = append(tokens, token{kind: literalToken})
+			i += n
+
+		case d.dollarQuotes && c == '$':
+			n := dollarQuoted(sql[i:])
+			if n == 0 {
+				tokens = append(tokens, token{kind: symbolToken, text: "$"})
+				n = 1
+			}
+			i += n
+
+		case isIdentStart(rune(c)) || c >= 0x80:
+			j := i
+			for j < len(sql) && (isIdentPart(rune(sql[j])) || sql[j] >= 0x80 ||
+				(d.pathIdentifiers && sql[j] == '-' && j+1 < len(sql) && isIdentPart(rune(sql[j+1])))) {
+				j++
+			}
+			text := sql[i:j]
+			if d.fold != nil {
+				text = d.fold(text)
+			}
+			tokens = append(tokens, token{kind: identToken, text: text})
+			i = j
+
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(sql) && (isIdentPart(rune(sql[j])) || sql[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{kind: literalToken})
+			i = j
+
+		default:
+			tokens = append(tokens, token{kind: symbolToken, text: string(c)})
+			i++
+		}
+	}
+	return tokens
+}
+
+// quoted returns the text between the quote at the start of s and its
+// closing quote, and the length of the quoted text in s. A doubled quote is
+// an escaped quote.
+func (d dialect) quoted(s string, quote byte, backslash bool) (string, int) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case backslash && s[i] == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case s[i] == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+			b.WriteByte(quote)
+		case s[i] == quote:
+			return b.String(), i + 1
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), len(s)
+}
+
+// dollarQuoted returns the length of the $tag$ quoted string at the start of
+// s, or 0 when s does not start with one.
+func dollarQuoted(s string) int {
+	end := strings.IndexByte(s[1:], '$')
+	if end < 0 {
+		return 0
+	}
+	tag := s[:end+2]
+	for _, r := range tag[1 : len(tag)-1] {
+		if !isIdentPart(r) {
+			return 0
+		}
+	}
+	closing := strings.Index(s[len(tag):], tag)
+	if closing < 0 {
+		return len(s)
+	}
+	return len(tag) + closing + len(tag)
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// notAliases are the keywords that can follow a table name in place of an
+// alias.
+var notAliases = toSet(
+	"where", "join", "inner", "left", "right", "full", "outer", "cross", "natural", "straight_join",
+	"on", "using", "group", "order", "having", "limit", "offset", "fetch", "union", "except",
+	"intersect", "minus", "window", "qualify", "for", "tablesample", "pivot", "unpivot", "with",
+	"select", "from", "as", "set", "when", "returning", "partition", "into", "values", "lateral",
+	"match_recognize", "at", "before", "changes", "connect", "start", "model",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+// tableReferences returns the tables a query reads from, each as the parts
+// of its qualified name, e.g. [sales public orders]. Common table
+// expressions, table functions and subqueries are not tables.
+func (d dialect) tableReferences(sql string) [][]string {
+	tokens := d.tokenize(sql)
+	ctes := commonTableExpressions(tokens)
+
+	var refs [][]string
+	seen := make(map[string]bool)
+	add := func(parts []string) {
+		if len(parts) == 1 && ctes[strings.ToLower(parts[0])] {
+			return
+		}
+		key := strings.Join(parts, "\x00")
+		if !seen[key] {
+			seen[key] = true
+			refs = append(refs, parts)
+		}
+	}
+
+	// queries tracks, for each level of parentheses, whether it holds a
+	// query, so that the FROM of EXTRACT(YEAR FROM ts) is not read as one.
+	queries := []bool{false}
+	for i, t := range tokens {
+		switch {
+		case t.isSymbol("("):
+			queries = append(queries, false)
+		case t.isSymbol(")"):
+			if len(queries) > 1 {
+				queries = queries[:len(queries)-1]
+			}
+		case t.is("select") || t.is("with"):
+			queries[len(queries)-1] = true
+		case t.is("from"):
+			if !queries[len(queries)-1] || (i > 0 && (tokens[i-1].is("distinct") || tokens[i-1].is("delete"))) {
+				continue
+			}
+			d.readTables(tokens[i+1:], true, add)
+		case t.is("join"):
+			d.readTables(tokens[i+1:], false, add)
+		case t.is("using"):
+			// the source of MERGE, but not the columns of JOIN ... USING (id)
+			d.readTables(tokens[i+1:], false, add)
+		}
+	}
+	return refs
+}
+
+// readTables reads the table at the start of tokens, and the ones after it
+// in a comma separated list of a FROM clause.
+func (d dialect) readTables(tokens []token, list bool, add func([]string)) {
+	for i := 0; i < len(tokens); {
+		for i < len(tokens) && (tokens[i].is("lateral") || tokens[i].is("only")) {
+			i++
+		}
+		if i >= len(tokens) || !tokens[i].isName() {
+			return
+		}
+
+		var parts []string
+		for {
+			parts = append(parts, d.nameParts(tokens[i])...)
+			i++
+			if i+1 < len(tokens) && tokens[i].isSymbol(".") && tokens[i+1].isName() {
+				i++
+				continue
+			}
+			break
+		}
+		if i < len(tokens) && tokens[i].isSymbol("(") {
+			// a table function, such as UNNEST(...) or generate_series(...)
+			return
+		}
+		add(parts)
+
+		if i < len(tokens) && tokens[i].is("as") {
+			i += 2
+		} else if i < len(tokens) && (tokens[i].kind == quotedToken ||
+			(tokens[i].kind == identToken && !notAliases[strings.ToLower(tokens[i].text)])) {
+			i++
+		}
+		if !list || i >= len(tokens) || !tokens[i].isSymbol(",") {
+			return
+		}
+		i++
+	}
+}
+
+func (d dialect) nameParts(t token) []string {
+	if t.kind == quotedToken && d.pathIdentifiers {
+		return strings.Split(t.text, ".")
+	}
+	return []string{t.text}
+}
+
+// commonTableExpressions returns the lower cased names defined as name AS (
+// or name (columns) AS ( in the WITH clauses of a query.
+func commonTableExpressions(tokens []token) map[string]bool {
+	ctes := make(map[string]bool)
+	for i, t := range tokens {
+		if !t.isName() {
+			continue
+		}
+		j := i + 1
+		if j < len(tokens) && tokens[j].isSymbol("(") {
+			j = closingParen(tokens, j) + 1
+		}
+		if j < len(tokens) && tokens[j].is("as") {
+			j++
+			for j < len(tokens) && (tokens[j].is("not") || tokens[j].is("materialized")) {
+				j++
+			}
+			if j < len(tokens) && tokens[j].isSymbol("(") {
+				ctes[strings.ToLower(t.text)] = true
+			}
+		}
+	}
+	return ctes
+}
+
+// closingParen returns the index of the parenthesis closing the one at i.
+func closingParen(tokens []token, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch {
+		case tokens[i].isSymbol("("):
+			depth++
+		case tokens[i].isSymbol(")"):
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(tokens)
+}
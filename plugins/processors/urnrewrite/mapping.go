@@ -0,0 +1,88 @@
+package urnrewrite
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// readMapping reads a lookup table of URNs from a CSV file with from and to
+// columns, or from a YAML map of URNs.
+func readMapping(path string) (map[string]string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readCSVMapping(path)
+	case ".yaml", ".yml":
+		return readYAMLMapping(path)
+	}
+	return nil, fmt.Errorf("mapping %q: use a .csv, .yaml or .yml file", path)
+}
+
+func readCSVMapping(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open mapping: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	mapping := make(map[string]string)
+	for first := true; ; first = false {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read mapping %q: %w", path, err)
+		}
+		if first && row[0] == "from" && row[1] == "to" {
+			continue
+		}
+		if err := addMapping(mapping, row[0], row[1]); err != nil {
+			line, _ := r.FieldPos(0)
+			return nil, fmt.Errorf("read mapping %q: line %d: %w", path, line, err)
+		}
+	}
+	return mapping, nil
+}
+
+func readYAMLMapping(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("open mapping: %w", err)
+	}
+
+	var entries map[string]string
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("read mapping %q: %w", path, err)
+	}
+	mapping := make(map[string]string, len(entries))
+	for from, to := range entries {
+		if err := addMapping(mapping, from, to); err != nil {
+			return nil, fmt.Errorf("read mapping %q: %w", path, err)
+		}
+	}
+	return mapping, nil
+}
+
+func addMapping(mapping map[string]string, from, to string) error {
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+	if from == "" || to == "" {
+		return fmt.Errorf("empty urn")
+	}
+	if prev, ok := mapping[from]; ok && prev != to {
+		return fmt.Errorf("urn %q is mapped to both %q and %q", from, prev, to)
+	}
+	mapping[from] = to
+	return nil
+}
@@ -0,0 +1,180 @@
+package urnrewrite
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"text/template"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/internal/tmplutil"
+	"github.com/raystack/meteor/registry"
+	log "github.com/raystack/salt/observability/logger"
+)
+
+//go:embed README.md
+var summary string
+
+type Config struct {
+	Rules []Rule `mapstructure:"rules" validate:"required_without=Mapping,dive"`
+	// Mapping is a CSV or YAML file of URNs to rewrite to other URNs. It is
+	// looked up before the rules.
+	Mapping string `mapstructure:"mapping" validate:"required_without=Rules"`
+}
+
+// Rule rewrites the URNs that match a regular expression, either with a
+// replacement that expands $1 or ${name}, or with a Go template.
+type Rule struct {
+	Match    string `mapstructure:"match" validate:"required,regexp"`
+	Replace  string `mapstructure:"replace"`
+	Template string `mapstructure:"template"`
+}
+
+type rule struct {
+	match    *regexp.Regexp
+	replace  string
+	template *template.Template
+}
+
+// Processor rewrites the URNs of entities and of both ends of their edges.
+type Processor struct {
+	plugins.BasePlugin
+	config  Config
+	rules   []rule
+	mapping map[string]string
+	logger  log.Logger
+}
+
+var sampleConfig = `
+# Rules run in order, the first one matching a URN rewrites it
+rules:
+  - match: "^urn:bigquery:staging-project:(.*)$"
+    replace: "urn:bigquery:prod-project:$1"
+  - match: "^urn:(?P<service>tableau|metabase):[^:]+:(?P<rest>.*)$"
+    template: 'urn:{{ "{{" }} .service }}:analytics:{{ "{{" }} .rest }}'
+# CSV file of from,to URNs, or a YAML map of URNs, looked up before the rules
+mapping: ./urn-mapping.csv`
+
+var info = plugins.Info{
+	Description:  "Rewrite the URNs of entities and edges.",
+	SampleConfig: sampleConfig,
+	Summary:      summary,
+	Tags:         []string{"oss", "transform"},
+}
+
+// New create a new processor
+func New(logger log.Logger) *Processor {
+	p := &Processor{
+		logger: logger,
+	}
+	p.BasePlugin = plugins.NewBasePlugin(info, &p.config)
+
+	return p
+}
+
+// Init initializes the processor
+func (p *Processor) Init(ctx context.Context, config plugins.Config) (err error) {
+	if err = p.BasePlugin.Init(ctx, config); err != nil {
+		return err
+	}
+
+	p.rules = make([]rule, 0, len(p.config.Rules))
+	for i, r := range p.config.Rules {
+		if (r.Replace == "") == (r.Template == "") {
+			return fmt.Errorf("rule %d: set either replace or template", i+1)
+		}
+		compiled := rule{match: regexp.MustCompile(r.Match), replace: r.Replace}
+		if r.Template != "" {
+			compiled.template, err = template.New(fmt.Sprintf("rule %d", i+1)).
+				Option("missingkey=error").
+				Funcs(tmplutil.Funcs()).
+				Parse(r.Template)
+			if err != nil {
+				return fmt.Errorf("rule %d: parse template: %w", i+1, err)
+			}
+		}
+		p.rules = append(p.rules, compiled)
+	}
+
+	p.mapping = nil
+	if p.config.Mapping != "" {
+		if p.mapping, err = readMapping(p.config.Mapping); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Process rewrites the URN of the entity and the URNs at both ends of its
+// edges.
+func (p *Processor) Process(ctx context.Context, src models.Record) (dst models.Record, err error) {
+	entity := src.Entity()
+	if entity.Urn, err = p.rewrite(entity.GetUrn()); err != nil {
+		return src, err
+	}
+	for _, e := range src.Edges() {
+		if e.SourceUrn, err = p.rewrite(e.GetSourceUrn()); err != nil {
+			return src, err
+		}
+		if e.TargetUrn, err = p.rewrite(e.GetTargetUrn()); err != nil {
+			return src, err
+		}
+	}
+
+	return models.NewRecord(entity, src.Edges()...), nil
+}
+
+// rewrite returns the URN from the mapping, or from the first rule that
+// matches it. Other URNs are returned as they are.
+func (p *Processor) rewrite(urn string) (string, error) {
+	if urn == "" {
+		return urn, nil
+	}
+	if to, ok := p.mapping[urn]; ok {
+		return to, nil
+	}
+
+	for _, r := range p.rules {
+		match := r.match.FindStringSubmatchIndex(urn)
+		if match == nil {
+			continue
+		}
+		if r.template == nil {
+			return r.match.ReplaceAllString(urn, r.replace), nil
+		}
+
+		var buf bytes.Buffer
+		if err := r.template.Execute(&buf, templateData(r.match, urn, match)); err != nil {
+			return urn, fmt.Errorf("rewrite urn %q: %w", urn, err)
+		}
+		return buf.String(), nil
+	}
+	return urn, nil
+}
+
+// templateData exposes the URN, its service, scope, type and id, and the
+// named groups of the rule to its template.
+func templateData(re *regexp.Regexp, urn string, match []int) map[string]string {
+	data := map[string]string{"urn": urn}
+	if service, scope, typ, id, ok := tmplutil.SplitURN(urn); ok {
+		data["service"], data["scope"], data["type"], data["id"] = service, scope, typ, id
+	}
+	for i, name := range re.SubexpNames() {
+		if name != "" && match[2*i] >= 0 {
+			data[name] = urn[match[2*i]:match[2*i+1]]
+		}
+	}
+	return data
+}
+
+func init() {
+	if err := registry.Processors.Register("urn_rewrite", func() plugins.Processor {
+		return New(plugins.GetLog())
+	}); err != nil {
+		return
+	}
+}
@@ -0,0 +1,162 @@
+//go:build plugins
+
+package urnrewrite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/meteor/models"
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/processors/urnrewrite"
+	testutils "github.com/raystack/meteor/test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]any
+		expected string
+	}{
+		{
+			name:     "empty config",
+			config:   map[string]any{},
+			expected: "field 'rules' failed validation: required_without=Mapping",
+		},
+		{
+			name:     "invalid match",
+			config:   map[string]any{"rules": []any{map[string]any{"match": "(urn", "replace": "x"}}},
+			expected: "must be a valid regular expression",
+		},
+		{
+			name:     "rule with replace and template",
+			config:   map[string]any{"rules": []any{map[string]any{"match": "urn", "replace": "x", "template": "y"}}},
+			expected: "rule 1: set either replace or template",
+		},
+		{
+			name:     "invalid template",
+			config:   map[string]any{"rules": []any{map[string]any{"match": "urn", "template": "{{ .id "}}},
+			expected: "rule 1: parse template",
+		},
+		{
+			name:     "missing mapping",
+			config:   map[string]any{"mapping": "testdata/missing.csv"},
+			expected: "open mapping",
+		},
+		{
+			name:     "unsupported mapping",
+			config:   map[string]any{"mapping": "testdata/mapping.json"},
+			expected: `mapping "testdata/mapping.json": use a .csv, .yaml or .yml file`,
+		},
+		{
+			name:     "conflicting mapping",
+			config:   map[string]any{"mapping": "testdata/conflicting-mapping.csv"},
+			expected: `line 2: urn "urn:tableau:prod:table:orders" is mapped to both`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run("should return error for "+tc.name, func(t *testing.T) {
+			p := urnrewrite.New(testutils.Logger)
+			err := p.Init(context.Background(), plugins.Config{RawConfig: tc.config})
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+
+	dashboard := func() models.Record {
+		urn := "urn:tableau:prod:workbook:sales"
+		return models.NewRecord(
+			models.NewEntity(urn, "dashboard", "sales", "tableau", nil),
+			models.DerivedFromEdge(urn, "urn:tableau:prod:table:orders", "tableau"),
+			models.DerivedFromEdge(urn, "urn:bigquery:staging-project:table:staging-project.sales.refunds", "tableau"),
+			models.OwnerEdge(urn, "urn:user:jane@example.com", "tableau"),
+		)
+	}
+	edgeURNs := func(edges []*meteorv1beta1.Edge) [][2]string {
+		var urns [][2]string
+		for _, e := range edges {
+			urns = append(urns, [2]string{e.GetSourceUrn(), e.GetTargetUrn()})
+		}
+		return urns
+	}
+
+	for _, mapping := range []string{"testdata/mapping.csv", "testdata/mapping.yaml"} {
+		t.Run("should rewrite urns with "+mapping+" and rules", func(t *testing.T) {
+			p := urnrewrite.New(testutils.Logger)
+			require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+				"mapping": mapping,
+				"rules": []any{
+					map[string]any{"match": "staging-project", "replace": "prod-project"},
+					map[string]any{"match": "^urn:tableau:", "template": "urn:tableau:analytics:{{ .type }}:{{ .id }}"},
+				},
+			}}))
+
+			dst, err := p.Process(ctx, dashboard())
+			require.NoError(t, err)
+
+			assert.Equal(t, "urn:tableau:analytics:workbook:sales", dst.Entity().GetUrn())
+			assert.Equal(t, [][2]string{
+				{"urn:tableau:analytics:workbook:sales", "urn:bigquery:prod-project:table:prod-project.sales.orders"},
+				{"urn:tableau:analytics:workbook:sales", "urn:bigquery:prod-project:table:prod-project.sales.refunds"},
+				{"urn:tableau:analytics:workbook:sales", "urn:user:jane@example.com"},
+			}, edgeURNs(dst.Edges()))
+		})
+	}
+
+	t.Run("should expand named groups in templates", func(t *testing.T) {
+		p := urnrewrite.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"rules": []any{
+				map[string]any{
+					"match":    `^urn:bigquery:(?P<project>[^:]+):table:[^.]+\.(?P<dataset>[^.]+)\.(?P<table>.+)$`,
+					"template": `urn:bigquery:{{ .project | replace "staging-" "prod-" }}:table:{{ .dataset | upper }}.{{ .table | regexReplace "_v[0-9]+$" "" }}`,
+				},
+			},
+		}}))
+
+		dst, err := p.Process(ctx, models.NewRecord(models.NewEntity(
+			"urn:bigquery:staging-project:table:staging-project.sales.orders_v2", "table", "orders", "bigquery", nil,
+		)))
+		require.NoError(t, err)
+		assert.Equal(t, "urn:bigquery:prod-project:table:SALES.orders", dst.Entity().GetUrn())
+	})
+
+	t.Run("should return error for templates of missing groups", func(t *testing.T) {
+		p := urnrewrite.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"rules": []any{map[string]any{"match": "^urn:(?P<service>tableau)|(?P<other>metabase):", "template": "{{ .other }}"}},
+		}}))
+
+		_, err := p.Process(ctx, dashboard())
+		assert.ErrorContains(t, err, `rewrite urn "urn:tableau:prod:workbook:sales"`)
+	})
+
+	t.Run("should leave other urns unchanged", func(t *testing.T) {
+		p := urnrewrite.New(testutils.Logger)
+		require.NoError(t, p.Init(ctx, plugins.Config{RawConfig: map[string]any{
+			"rules": []any{map[string]any{"match": "^urn:metabase:", "replace": "urn:metabase:analytics:"}},
+		}}))
+
+		dst, err := p.Process(ctx, dashboard())
+		require.NoError(t, err)
+		assert.Equal(t, dashboard().Entity().GetUrn(), dst.Entity().GetUrn())
+		assert.Equal(t, edgeURNs(dashboard().Edges()), edgeURNs(dst.Edges()))
+	})
+}
+
+func TestConformance(t *testing.T) {
+	plugintest.ProcessorSuite{
+		New: func() plugins.Processor { return urnrewrite.New(testutils.Logger) },
+		Config: plugins.Config{RawConfig: map[string]any{
+			"rules": []any{map[string]any{"match": "^urn:([^:]+):staging:", "replace": "urn:$1:prod:"}},
+		}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -0,0 +1,267 @@
+// Package scaffold generates the skeleton of a new built-in plugin: the
+// package with its Config and plugins.Info, a README, test stubs and the
+// registration import in populate.go.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+)
+
+// modulePath is the import path of the meteor module.
+const modulePath = "github.com/raystack/meteor"
+
+//go:embed templates
+var templates embed.FS
+
+var validName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Params describes the plugin to generate.
+type Params struct {
+	Type plugins.PluginType
+	// Name is the name the plugin is registered with, in snake_case.
+	Name string
+	// Entities lists the entity types emitted by an extractor.
+	Entities []string
+	// Edges lists the edge types emitted by an extractor.
+	Edges []plugins.EdgeInfo
+}
+
+// Entity is an entity type rendered in the templates.
+type Entity struct {
+	plugins.EntityInfo
+	// Ident is the entity type in CamelCase, for use in Go identifiers.
+	Ident string
+}
+
+// Data is passed to the templates.
+type Data struct {
+	Type    plugins.PluginType
+	Name    string
+	Package string
+	Title   string
+	// Dir is the plugin directory relative to the repository root.
+	Dir      string
+	Entities []Entity
+	Edges    []plugins.EdgeInfo
+}
+
+// Scaffold validates the params and returns the data for the templates.
+func Scaffold(p Params) (*Data, error) {
+	if !validName.MatchString(p.Name) {
+		return nil, fmt.Errorf("invalid plugin name %q: use lower case letters, digits and underscores", p.Name)
+	}
+
+	var registered bool
+	switch p.Type {
+	case plugins.PluginTypeExtractor:
+		_, err := registry.Extractors.Get(p.Name)
+		registered = err == nil
+		if len(p.Entities) == 0 {
+			return nil, errors.New("an extractor needs at least one entity type")
+		}
+	case plugins.PluginTypeProcessor:
+		_, err := registry.Processors.Get(p.Name)
+		registered = err == nil
+	case plugins.PluginTypeSink:
+		_, err := registry.Sinks.Get(p.Name)
+		registered = err == nil
+	default:
+		return nil, fmt.Errorf("invalid plugin type %q: expected extractor, processor or sink", p.Type)
+	}
+	if registered {
+		return nil, fmt.Errorf("%s %q already exists", p.Type, p.Name)
+	}
+
+	data := &Data{
+		Type:    p.Type,
+		Name:    p.Name,
+		Package: strings.ReplaceAll(p.Name, "_", ""),
+		Title:   title(p.Name),
+		Dir:     filepath.Join("plugins", string(p.Type)+"s", p.Name),
+		Edges:   p.Edges,
+	}
+	for _, typ := range p.Entities {
+		typ = strings.TrimSpace(typ)
+		if !validName.MatchString(typ) {
+			return nil, fmt.Errorf("invalid entity type %q: use lower case letters, digits and underscores", typ)
+		}
+		data.Entities = append(data.Entities, Entity{
+			EntityInfo: plugins.EntityInfo{
+				Type:       typ,
+				URNPattern: fmt.Sprintf("urn:%s:{scope}:%s:{id}", p.Name, typ),
+			},
+			Ident: strings.ReplaceAll(title(typ), " ", ""),
+		})
+	}
+	for _, e := range p.Edges {
+		if e.Type == "" || e.From == "" || e.To == "" {
+			return nil, fmt.Errorf("invalid edge %q: expected type:from:to", e.Type+":"+e.From+":"+e.To)
+		}
+	}
+
+	return data, nil
+}
+
+// ParseEdge parses an edge given as type:from:to, e.g. "owned_by:table:user".
+func ParseEdge(s string) (plugins.EdgeInfo, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return plugins.EdgeInfo{}, fmt.Errorf("invalid edge %q: expected type:from:to", s)
+	}
+	return plugins.EdgeInfo{Type: parts[0], From: parts[1], To: parts[2]}, nil
+}
+
+// Generate writes the plugin package below root, the root of the meteor
+// repository, and registers it in the populate.go of its plugin type when
+// present. It returns the paths of the files written.
+func Generate(p Params, root string) ([]string, error) {
+	data, err := Scaffold(p)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(root, data.Dir)
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("directory %s already exists", dir)
+	}
+
+	files, err := Render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create plugin dir: %w", err)
+	}
+	var written []string
+	for _, name := range sortedKeys(files) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, files[name], 0o644); err != nil {
+			return written, fmt.Errorf("write %s: %w", path, err)
+		}
+		written = append(written, path)
+	}
+
+	populate := filepath.Join(root, "plugins", string(p.Type)+"s", "populate.go")
+	if _, err := os.Stat(populate); errors.Is(err, fs.ErrNotExist) {
+		// outside the meteor repository there is nothing to register with
+		return written, nil
+	}
+	if err := addImport(populate, modulePath+"/"+filepath.ToSlash(data.Dir)); err != nil {
+		return written, err
+	}
+	return append(written, populate), nil
+}
+
+// Render executes the templates of the plugin type and returns the file
+// contents keyed by file name.
+func Render(data *Data) (map[string][]byte, error) {
+	tmplDir := "templates/" + string(data.Type)
+	entries, err := fs.ReadDir(templates, tmplDir)
+	if err != nil {
+		return nil, fmt.Errorf("read templates: %w", err)
+	}
+
+	files := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		tmpl, err := template.ParseFS(templates, tmplDir+"/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("parse template %s: %w", entry.Name(), err)
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("execute template %s: %w", entry.Name(), err)
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ".tmpl")
+		name = strings.ReplaceAll(name, "plugin", data.Name)
+		content := buf.Bytes()
+		if strings.HasSuffix(name, ".go") {
+			if content, err = format.Source(content); err != nil {
+				return nil, fmt.Errorf("format %s: %w", name, err)
+			}
+		}
+		files[name] = content
+	}
+	return files, nil
+}
+
+// addImport adds a blank import of pkg to the populate.go file at path.
+func addImport(path, pkg string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("register plugin: %w", err)
+	}
+
+	src := string(b)
+	start := strings.Index(src, "import (\n")
+	if start < 0 {
+		return fmt.Errorf("register plugin: no import block in %s", path)
+	}
+	start += len("import (\n")
+	end := strings.Index(src[start:], ")")
+	if end < 0 {
+		return fmt.Errorf("register plugin: unterminated import block in %s", path)
+	}
+	end += start
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(src[start:end], "\n"), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	newLine := fmt.Sprintf("\t_ %q", pkg)
+	if slices.Contains(lines, newLine) {
+		return nil
+	}
+	// insert before the first greater line rather than sorting, so that
+	// existing entries are left where they are
+	at := len(lines)
+	for i, line := range lines {
+		if line > newLine {
+			at = i
+			break
+		}
+	}
+	lines = slices.Insert(lines, at, newLine)
+
+	out := src[:start] + strings.Join(lines, "\n") + "\n" + src[end:]
+	return os.WriteFile(path, []byte(out), 0o644)
+}
+
+// title turns snake_case into space separated words with upper case initials.
+func title(s string) string {
+	words := strings.Split(s, "_")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
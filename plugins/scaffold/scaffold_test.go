@@ -0,0 +1,198 @@
+//go:build plugins
+// +build plugins
+
+package scaffold_test
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/scaffold"
+	"github.com/raystack/meteor/registry"
+	"github.com/raystack/meteor/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// populate is deliberately unsorted: existing imports must stay where they are.
+const populate = `package %[1]s
+
+import (
+	_ "github.com/raystack/meteor/plugins/%[1]s/abc"
+	_ "github.com/raystack/meteor/plugins/%[1]s/zeta"
+	_ "github.com/raystack/meteor/plugins/%[1]s/alpha"
+)
+`
+
+func TestScaffold(t *testing.T) {
+	require.NoError(t, registry.Extractors.Register("taken", func() plugins.Extractor {
+		return mocks.NewExtractor()
+	}))
+
+	tests := []struct {
+		name        string
+		params      scaffold.Params
+		expected    *scaffold.Data
+		expectedErr string
+	}{
+		{
+			name: "extractor",
+			params: scaffold.Params{
+				Type:     plugins.PluginTypeExtractor,
+				Name:     "acme_cloud",
+				Entities: []string{"table", "data_model"},
+				Edges:    []plugins.EdgeInfo{{Type: "owned_by", From: "table", To: "user"}},
+			},
+			expected: &scaffold.Data{
+				Type:    plugins.PluginTypeExtractor,
+				Name:    "acme_cloud",
+				Package: "acmecloud",
+				Title:   "Acme Cloud",
+				Dir:     filepath.Join("plugins", "extractors", "acme_cloud"),
+				Entities: []scaffold.Entity{
+					{EntityInfo: plugins.EntityInfo{Type: "table", URNPattern: "urn:acme_cloud:{scope}:table:{id}"}, Ident: "Table"},
+					{EntityInfo: plugins.EntityInfo{Type: "data_model", URNPattern: "urn:acme_cloud:{scope}:data_model:{id}"}, Ident: "DataModel"},
+				},
+				Edges: []plugins.EdgeInfo{{Type: "owned_by", From: "table", To: "user"}},
+			},
+		},
+		{
+			name:   "sink",
+			params: scaffold.Params{Type: plugins.PluginTypeSink, Name: "acme"},
+			expected: &scaffold.Data{
+				Type:    plugins.PluginTypeSink,
+				Name:    "acme",
+				Package: "acme",
+				Title:   "Acme",
+				Dir:     filepath.Join("plugins", "sinks", "acme"),
+			},
+		},
+		{
+			name:        "invalid name",
+			params:      scaffold.Params{Type: plugins.PluginTypeProcessor, Name: "Acme-Cloud"},
+			expectedErr: `invalid plugin name "Acme-Cloud"`,
+		},
+		{
+			name:        "invalid type",
+			params:      scaffold.Params{Type: "loader", Name: "acme"},
+			expectedErr: `invalid plugin type "loader"`,
+		},
+		{
+			name:        "extractor without entities",
+			params:      scaffold.Params{Type: plugins.PluginTypeExtractor, Name: "acme"},
+			expectedErr: "an extractor needs at least one entity type",
+		},
+		{
+			name:        "invalid entity",
+			params:      scaffold.Params{Type: plugins.PluginTypeExtractor, Name: "acme", Entities: []string{"Table"}},
+			expectedErr: `invalid entity type "Table"`,
+		},
+		{
+			name:        "already registered",
+			params:      scaffold.Params{Type: plugins.PluginTypeExtractor, Name: "taken", Entities: []string{"table"}},
+			expectedErr: `extractor "taken" already exists`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := scaffold.Scaffold(tc.params)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestParseEdge(t *testing.T) {
+	edge, err := scaffold.ParseEdge("owned_by:table:user")
+	require.NoError(t, err)
+	assert.Equal(t, plugins.EdgeInfo{Type: "owned_by", From: "table", To: "user"}, edge)
+
+	for _, s := range []string{"owned_by", "owned_by:table", "owned_by::user", "a:b:c:d"} {
+		_, err := scaffold.ParseEdge(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	for _, typ := range []plugins.PluginType{plugins.PluginTypeExtractor, plugins.PluginTypeProcessor, plugins.PluginTypeSink} {
+		t.Run(string(typ), func(t *testing.T) {
+			root := t.TempDir()
+			typeDir := filepath.Join(root, "plugins", string(typ)+"s")
+			require.NoError(t, os.MkdirAll(typeDir, 0o755))
+			original := fmt.Sprintf(populate, string(typ)+"s")
+			require.NoError(t, os.WriteFile(filepath.Join(typeDir, "populate.go"), []byte(original), 0o644))
+
+			params := scaffold.Params{
+				Type:     typ,
+				Name:     "acme_cloud",
+				Entities: []string{"table", "dashboard"},
+				Edges:    []plugins.EdgeInfo{{Type: "owned_by", From: "table", To: "user"}},
+			}
+			files, err := scaffold.Generate(params, root)
+			require.NoError(t, err)
+
+			dir := filepath.Join(typeDir, "acme_cloud")
+			assert.Equal(t, []string{
+				filepath.Join(dir, "README.md"),
+				filepath.Join(dir, "acme_cloud.go"),
+				filepath.Join(dir, "acme_cloud_test.go"),
+				filepath.Join(typeDir, "populate.go"),
+			}, files)
+
+			for _, name := range []string{"acme_cloud.go", "acme_cloud_test.go"} {
+				_, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, name), nil, parser.AllErrors)
+				assert.NoError(t, err, name)
+			}
+
+			readme, err := os.ReadFile(filepath.Join(dir, "README.md"))
+			require.NoError(t, err)
+			assert.Contains(t, string(readme), "# Acme Cloud")
+			assert.Contains(t, string(readme), "guide.mdx#adding-a-new-"+string(typ))
+
+			b, err := os.ReadFile(filepath.Join(typeDir, "populate.go"))
+			require.NoError(t, err)
+			pkg := "github.com/raystack/meteor/plugins/" + string(typ) + "s/"
+			assert.Equal(t, strings.Replace(original,
+				"\t_ \""+pkg+"zeta\"",
+				"\t_ \""+pkg+"acme_cloud\"\n\t_ \""+pkg+"zeta\"", 1),
+				string(b))
+
+			_, err = scaffold.Generate(params, root)
+			assert.ErrorContains(t, err, "already exists")
+		})
+	}
+}
+
+func TestGenerateExtractor(t *testing.T) {
+	root := t.TempDir()
+	files, err := scaffold.Generate(scaffold.Params{
+		Type:     plugins.PluginTypeExtractor,
+		Name:     "acme",
+		Entities: []string{"table", "data_model"},
+		Edges:    []plugins.EdgeInfo{{Type: "owned_by", From: "table", To: "user"}},
+	}, root)
+	require.NoError(t, err)
+	assert.Len(t, files, 3, "populate.go is skipped when missing")
+
+	src, err := os.ReadFile(filepath.Join(root, "plugins", "extractors", "acme", "acme.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		`{Type: "table", URNPattern: "urn:acme:{scope}:table:{id}"}`,
+		`{Type: "data_model", URNPattern: "urn:acme:{scope}:data_model:{id}"}`,
+		`{Type: "owned_by", From: "table", To: "user"}`,
+		"func (e *Extractor) buildDataModelRecord(",
+		`registry.Extractors.Register("acme"`,
+	} {
+		assert.Contains(t, string(src), want)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build plugins
+// +build plugins
+
+package azure_blob_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/sinks/azure_blob"
+	"github.com/raystack/meteor/test/utils"
+)
+
+// Sink and Close are not checked: the sink needs a live storage account and
+// the package has no fake blob server.
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New: func() plugins.Syncer { return azure_blob.New(utils.Logger) },
+	}.Run(t)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/raystack/meteor/models"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/plugins/sinks/compass"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -454,3 +455,13 @@ func decodeBody(t *testing.T, req *http.Request, v any) {
 	require.NoError(t, err)
 	require.NoError(t, json.Unmarshal(bodyBytes, v))
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New: func() plugins.Syncer {
+			return compass.New(&mockHTTPClient{}, testutils.Logger)
+		},
+		Config:    plugins.Config{RawConfig: map[string]any{"host": host}},
+		Reachable: true,
+	}.Run(t)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/raystack/meteor/models"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/plugins/sinks/console"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -130,3 +131,11 @@ func TestClose(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New:              func() plugins.Syncer { return console.New(testutils.Logger) },
+		EmptyConfigValid: true,
+		Reachable:        true,
+	}.Run(t)
+}
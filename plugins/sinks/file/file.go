@@ -89,7 +89,13 @@ func (s *Sink) Sink(ctx context.Context, batch []models.Record) (err error) {
 }
 
 func (s *Sink) Close() (err error) {
-	return s.File.Close()
+	if s.File == nil {
+		return nil
+	}
+
+	err = s.File.Close()
+	s.File = nil
+	return err
 }
 
 func (s *Sink) ndjsonOut(batch []models.Record) error {
@@ -6,11 +6,13 @@ package file_test
 import (
 	"context"
 	_ "embed"
+	"path/filepath"
 	"testing"
 
 	"github.com/raystack/meteor/models"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	f "github.com/raystack/meteor/plugins/sinks/file"
 	testUtils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -204,3 +206,14 @@ func getExpectedVal(t *testing.T) []models.Record {
 		}),
 	}
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New: func() plugins.Syncer { return f.New(testUtils.Logger) },
+		Config: plugins.Config{RawConfig: map[string]any{
+			"path":   filepath.Join(t.TempDir(), "out.ndjson"),
+			"format": "ndjson",
+		}},
+		Reachable: true,
+	}.Run(t)
+}
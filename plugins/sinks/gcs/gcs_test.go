@@ -9,6 +9,7 @@ import (
 
 	"github.com/raystack/meteor/models"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	g "github.com/raystack/meteor/plugins/sinks/gcs"
 	testUtils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -102,3 +103,12 @@ func (m *mockWriter) Close() error {
 
 	return args.Error(0)
 }
+
+// Sink and Close are not checked: Init opens a writer on a real bucket and
+// the package has no fake storage server.
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New:    func() plugins.Syncer { return g.New(testUtils.Logger) },
+		Config: plugins.Config{RawConfig: map[string]any{"project_id": "google-project-id", "url": "gcs://bucket_name/target_folder"}},
+	}.Run(t)
+}
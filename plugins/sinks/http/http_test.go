@@ -14,6 +14,7 @@ import (
 	"github.com/raystack/meteor/models"
 	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	h "github.com/raystack/meteor/plugins/sinks/http"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -245,3 +246,14 @@ func TestInfo(t *testing.T) {
 	info := h.New(&http.Client{}, testutils.Logger).Info()
 	assert.Equal(t, summary, info.Summary)
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New: func() plugins.Syncer { return h.New(&http.Client{}, testutils.Logger) },
+		Config: plugins.Config{RawConfig: map[string]any{
+			"url":    "http://127.0.0.1:54927",
+			"method": "PUT",
+		}},
+		Reachable: true,
+	}.Run(t)
+}
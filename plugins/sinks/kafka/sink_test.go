@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/plugins/sinks/kafka"
 	testutils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -61,3 +62,11 @@ func TestInit(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// Sink and Close are not checked: the sink needs a live broker and the
+// package has no test container for it.
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New: func() plugins.Syncer { return kafka.New(testutils.Logger) },
+	}.Run(t)
+}
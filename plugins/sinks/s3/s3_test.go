@@ -0,0 +1,21 @@
+//go:build plugins
+// +build plugins
+
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
+	"github.com/raystack/meteor/plugins/sinks/s3"
+	"github.com/raystack/meteor/test/utils"
+)
+
+// Sink and Close are not checked: the sink needs a live bucket and the
+// package has no fake S3 server.
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New: func() plugins.Syncer { return s3.New(utils.Logger) },
+	}.Run(t)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/raystack/meteor/models"
 	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/plugins/plugintest"
 	"github.com/raystack/meteor/plugins/sinks/stencil"
 	testUtils "github.com/raystack/meteor/test/utils"
 	"github.com/stretchr/testify/assert"
@@ -499,3 +500,17 @@ func (m *mockHTTPClient) Assert(t *testing.T) {
 
 	assert.Equal(t, string(expectedBytes), string(bodyBytes))
 }
+
+func TestConformance(t *testing.T) {
+	plugintest.SinkSuite{
+		New: func() plugins.Syncer {
+			return stencil.New(&mockHTTPClient{}, testUtils.Logger)
+		},
+		Config: plugins.Config{RawConfig: map[string]any{
+			"host":         host,
+			"namespace_id": namespaceID,
+			"format":       "json",
+		}},
+		Reachable: true,
+	}.Run(t)
+}
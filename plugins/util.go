@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -29,10 +30,22 @@ func init() {
 		}
 		return configName
 	})
+	if err := validate.RegisterValidation("regexp", func(fl validator.FieldLevel) bool {
+		_, err := regexp.Compile(fl.Field().String())
+		return err == nil
+	}); err != nil {
+		panic(err)
+	}
 }
 
 // BuildConfig builds a config struct from a map
 func buildConfig(configMap map[string]any, c any) (err error) {
+	return decodeConfig(configMap, c, "Config.")
+}
+
+// decodeConfig builds a config struct from a map. keyPrefix is trimmed from
+// the keys of validation errors.
+func decodeConfig(configMap map[string]any, c any, keyPrefix string) (err error) {
 	defaults.SetDefaults(c)
 
 	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
@@ -56,7 +69,7 @@ func buildConfig(configMap map[string]any, c any) (err error) {
 	if errors.As(err, &validationErr) {
 		var configErrors []ConfigError
 		for _, fieldErr := range validationErr {
-			key := strings.TrimPrefix(fieldErr.Namespace(), "Config.")
+			key := strings.TrimPrefix(fieldErr.Namespace(), keyPrefix)
 			configErrors = append(configErrors, ConfigError{
 				Key:     key,
 				Message: humanizeValidationError(fieldErr, key),
@@ -176,6 +189,8 @@ func humanizeValidationError(fe validator.FieldError, key string) string {
 		return fmt.Sprintf("field '%s' must be a valid email address, got %q", key, fe.Value())
 	case "hostname":
 		return fmt.Sprintf("field '%s' must be a valid hostname, got %q", key, fe.Value())
+	case "regexp":
+		return fmt.Sprintf("field '%s' must be a valid regular expression, got %q", key, fe.Value())
 	default:
 		return fmt.Sprintf("field '%s' failed validation: %s=%s", key, fe.Tag(), fe.Param())
 	}
@@ -0,0 +1,262 @@
+package recipe
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+	"gopkg.in/yaml.v3"
+)
+
+// BuildParams holds what is needed to build a complete recipe.
+type BuildParams struct {
+	Name       string
+	Scope      string
+	Source     string
+	Sinks      []string
+	Processors []string
+	// Values holds config values keyed by "source.<key>", "sinks.<name>.<key>"
+	// or "processors.<name>.<key>". Keys of nested config use dots.
+	Values map[string]string
+}
+
+type builtRecipe struct {
+	Name       string        `yaml:"name"`
+	Version    string        `yaml:"version"`
+	Source     builtPlugin   `yaml:"source"`
+	Sinks      []builtPlugin `yaml:"sinks,omitempty"`
+	Processors []builtPlugin `yaml:"processors,omitempty"`
+}
+
+type builtPlugin struct {
+	Name   string         `yaml:"name"`
+	Scope  string         `yaml:"scope,omitempty"`
+	Config map[string]any `yaml:"config,omitempty"`
+}
+
+// PluginConfigFields describes the config of a registered plugin. It returns
+// no fields for plugins that cannot describe their config.
+func PluginConfigFields(typ plugins.PluginType, name string) ([]plugins.ConfigField, error) {
+	p, err := getPlugin(typ, name)
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := p.(plugins.ConfigDescriber); ok {
+		return d.ConfigFields(), nil
+	}
+	return nil, nil
+}
+
+// Build creates a recipe from the given plugins and config values. Every
+// plugin validates its config, so the result passes lint.
+func Build(p BuildParams) ([]byte, error) {
+	values, err := splitValues(p)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := buildPlugin(plugins.PluginTypeExtractor, p.Source, p.Scope, values["source"])
+	if err != nil {
+		return nil, err
+	}
+	src.Scope = p.Scope
+	out := builtRecipe{
+		Name:    p.Name,
+		Version: recipeVersions[len(recipeVersions)-1],
+		Source:  src,
+	}
+	for _, name := range p.Sinks {
+		sink, err := buildPlugin(plugins.PluginTypeSink, name, p.Scope, values["sinks."+name])
+		if err != nil {
+			return nil, err
+		}
+		out.Sinks = append(out.Sinks, sink)
+	}
+	for _, name := range p.Processors {
+		proc, err := buildPlugin(plugins.PluginTypeProcessor, name, p.Scope, values["processors."+name])
+		if err != nil {
+			return nil, err
+		}
+		out.Processors = append(out.Processors, proc)
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(out); err != nil {
+		return nil, fmt.Errorf("encode recipe: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("encode recipe: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// splitValues groups the values by the plugin they belong to, keyed by
+// "source", "sinks.<name>" or "processors.<name>".
+func splitValues(p BuildParams) (map[string]map[string]string, error) {
+	owners := map[string]bool{"source": true}
+	for _, name := range p.Sinks {
+		owners["sinks."+name] = true
+	}
+	for _, name := range p.Processors {
+		owners["processors."+name] = true
+	}
+
+	out := make(map[string]map[string]string)
+	for key, value := range p.Values {
+		owner, rest, ok := strings.Cut(key, ".")
+		if ok && owner != "source" {
+			var name string
+			name, rest, ok = strings.Cut(rest, ".")
+			owner += "." + name
+		}
+		if !ok || rest == "" || !owners[owner] {
+			return nil, fmt.Errorf("invalid key %q: expected source.<key>, sinks.<name>.<key> or processors.<name>.<key> of a plugin in the recipe", key)
+		}
+		if out[owner] == nil {
+			out[owner] = make(map[string]string)
+		}
+		out[owner][rest] = value
+	}
+	return out, nil
+}
+
+func buildPlugin(typ plugins.PluginType, name, scope string, values map[string]string) (builtPlugin, error) {
+	p, err := getPlugin(typ, name)
+	if err != nil {
+		return builtPlugin{}, err
+	}
+
+	fields := make(map[string]plugins.ConfigField)
+	if d, ok := p.(plugins.ConfigDescriber); ok {
+		for _, f := range d.ConfigFields() {
+			fields[f.Key] = f
+		}
+	}
+
+	cfg := make(map[string]any)
+	for key, raw := range values {
+		field, ok := lookupField(fields, key)
+		if !ok && len(fields) > 0 {
+			return builtPlugin{}, fmt.Errorf("%s %q has no config key %q, known keys: %s", typ, name, key, strings.Join(fieldKeys(fields), ", "))
+		}
+		value, err := parseValue(field.Type, raw)
+		if err != nil {
+			return builtPlugin{}, fmt.Errorf("%s %q: %s: %w", typ, name, key, err)
+		}
+		if err := setNested(cfg, key, value); err != nil {
+			return builtPlugin{}, fmt.Errorf("%s %q: %w", typ, name, err)
+		}
+	}
+
+	if err := p.Validate(plugins.Config{URNScope: scope, RawConfig: cfg}); err != nil {
+		return builtPlugin{}, fmt.Errorf("%s %q: %w", typ, name, err)
+	}
+	return builtPlugin{Name: name, Config: cfg}, nil
+}
+
+func getPlugin(typ plugins.PluginType, name string) (interface{ Validate(plugins.Config) error }, error) {
+	switch typ {
+	case plugins.PluginTypeExtractor:
+		return registry.Extractors.Get(name)
+	case plugins.PluginTypeSink:
+		return registry.Sinks.Get(name)
+	case plugins.PluginTypeProcessor:
+		return registry.Processors.Get(name)
+	}
+	return nil, fmt.Errorf("unknown plugin type %q", typ)
+}
+
+// parseValue converts a value given on the command line to the type of its
+// config field. Lists and maps may be given as "a,b" and "k=v,k=v" or in
+// yaml flow style. Untyped values are parsed as yaml scalars.
+func parseValue(typ, raw string) (any, error) {
+	switch typ {
+	case plugins.FieldTypeString, plugins.FieldTypeDuration:
+		return raw, nil
+	case plugins.FieldTypeList:
+		if !strings.HasPrefix(strings.TrimSpace(raw), "[") {
+			var list []any
+			for _, v := range strings.Split(raw, ",") {
+				list = append(list, strings.TrimSpace(v))
+			}
+			return list, nil
+		}
+	case plugins.FieldTypeMap:
+		if !strings.HasPrefix(strings.TrimSpace(raw), "{") {
+			m := make(map[string]any)
+			for _, kv := range strings.Split(raw, ",") {
+				k, v, ok := strings.Cut(kv, "=")
+				if !ok {
+					return nil, fmt.Errorf("parse %q: expected key=value pairs", raw)
+				}
+				m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+			}
+			return m, nil
+		}
+	}
+
+	var v any
+	if err := yaml.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, fmt.Errorf("parse %q: %w", raw, err)
+	}
+	if v == nil {
+		return raw, nil
+	}
+	return v, nil
+}
+
+// lookupField finds the field of a key, which may also be an entry of a
+// map field such as "labels.team".
+func lookupField(fields map[string]plugins.ConfigField, key string) (plugins.ConfigField, bool) {
+	if f, ok := fields[key]; ok {
+		return f, true
+	}
+	for i := strings.LastIndex(key, "."); i > 0; i = strings.LastIndex(key[:i], ".") {
+		if f, ok := fields[key[:i]]; ok && f.Type == plugins.FieldTypeMap {
+			return plugins.ConfigField{Key: key}, true
+		}
+	}
+	return plugins.ConfigField{}, false
+}
+
+// SetConfigValue parses a value given on the command line as yaml and sets
+// it at a dotted key of config.
+func SetConfigValue(config map[string]any, key, raw string) error {
+	value, err := parseValue("", raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	return setNested(config, key, value)
+}
+
+// setNested sets a dotted key in a tree of maps.
+func setNested(m map[string]any, key string, value any) error {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := m[part]
+		if !ok {
+			next = make(map[string]any)
+			m[part] = next
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("config key %q conflicts with %q", key, part)
+		}
+		m = child
+	}
+	m[parts[len(parts)-1]] = value
+	return nil
+}
+
+func fieldKeys(fields map[string]plugins.ConfigField) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,135 @@
+package recipe_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/recipe"
+	"github.com/raystack/meteor/registry"
+	"github.com/raystack/meteor/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type builderConfig struct {
+	Host    string            `mapstructure:"host" validate:"required"`
+	Port    int               `mapstructure:"port" default:"5432"`
+	Tables  []string          `mapstructure:"tables"`
+	Labels  map[string]string `mapstructure:"labels"`
+	Exclude struct {
+		Schemas []string `mapstructure:"schemas"`
+	} `mapstructure:"exclude"`
+}
+
+type builderExtractor struct {
+	plugins.BaseExtractor
+	config builderConfig
+}
+
+func newBuilderExtractor() plugins.Extractor {
+	e := &builderExtractor{}
+	e.BaseExtractor = plugins.NewBaseExtractor(plugins.Info{Description: "builder extractor"}, &e.config)
+	return e
+}
+
+func (e *builderExtractor) Extract(context.Context, plugins.Emit) error { return nil }
+
+func TestBuild(t *testing.T) {
+	require.NoError(t, registry.Extractors.Register("builder-extractor", newBuilderExtractor))
+	require.NoError(t, registry.Sinks.Register("builder-sink", func() plugins.Syncer {
+		sink := mocks.NewSink()
+		sink.On("Validate", plugins.Config{URNScope: "acme", RawConfig: map[string]any{"url": "http://localhost", "batch": 10}}).Return(nil)
+		return sink
+	}))
+
+	params := func(values map[string]string) recipe.BuildParams {
+		return recipe.BuildParams{
+			Name:   "sample",
+			Scope:  "acme",
+			Source: "builder-extractor",
+			Sinks:  []string{"builder-sink"},
+			Values: values,
+		}
+	}
+
+	t.Run("should build a complete recipe from typed values", func(t *testing.T) {
+		out, err := recipe.Build(params(map[string]string{
+			"source.host":              "localhost",
+			"source.port":              "6543",
+			"source.tables":            "orders, users",
+			"source.labels":            "team=data",
+			"source.exclude.schemas":   "[tmp]",
+			"sinks.builder-sink.url":   "http://localhost",
+			"sinks.builder-sink.batch": "10",
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, heredoc.Doc(`
+			name: sample
+			version: v1beta1
+			source:
+			  name: builder-extractor
+			  scope: acme
+			  config:
+			    exclude:
+			      schemas:
+			        - tmp
+			    host: localhost
+			    labels:
+			      team: data
+			    port: 6543
+			    tables:
+			      - orders
+			      - users
+			sinks:
+			  - name: builder-sink
+			    config:
+			      batch: 10
+			      url: http://localhost
+		`), string(out))
+
+		path := filepath.Join(t.TempDir(), "sample.yaml")
+		require.NoError(t, os.WriteFile(path, out, 0o600))
+		recipes, err := recipe.NewReader(testLog, "").Read(path)
+		require.NoError(t, err)
+		assert.Equal(t, "localhost", recipes[0].Source.Config["host"])
+	})
+
+	t.Run("should return error when a required field is missing", func(t *testing.T) {
+		_, err := recipe.Build(params(map[string]string{"source.port": "1"}))
+		assert.ErrorContains(t, err, "host' is required")
+	})
+
+	t.Run("should return error for unknown keys of a described config", func(t *testing.T) {
+		_, err := recipe.Build(params(map[string]string{"source.hots": "localhost"}))
+		assert.ErrorContains(t, err, `has no config key "hots", known keys: exclude.schemas, host, labels, port, tables`)
+	})
+
+	t.Run("should return error for keys of plugins not in the recipe", func(t *testing.T) {
+		_, err := recipe.Build(params(map[string]string{"source.host": "localhost", "sinks.other.url": "x"}))
+		assert.ErrorContains(t, err, `invalid key "sinks.other.url"`)
+	})
+}
+
+func TestPluginConfigFields(t *testing.T) {
+	require.NoError(t, registry.Extractors.Register("fields-extractor", newBuilderExtractor))
+
+	fields, err := recipe.PluginConfigFields(plugins.PluginTypeExtractor, "fields-extractor")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host", "port", "tables", "labels", "exclude.schemas"}, configKeys(fields))
+
+	_, err = recipe.PluginConfigFields(plugins.PluginTypeSink, "not-registered")
+	assert.Error(t, err)
+}
+
+func configKeys(fields []plugins.ConfigField) []string {
+	var keys []string
+	for _, f := range fields {
+		keys = append(keys, f.Key)
+	}
+	return keys
+}
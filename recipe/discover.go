@@ -0,0 +1,60 @@
+package recipe
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/registry"
+)
+
+var unsafeFileName = regexp.MustCompile(`[^a-z0-9_.-]+`)
+
+// Discover lists the partitions of the source of an extractor, such as the
+// databases of a server, as data for FromTemplate. Each partition gets the
+// file name <extractor>-<partition>.
+func Discover(ctx context.Context, extractor string, config map[string]any) ([]TemplateData, error) {
+	extr, err := registry.Extractors.Get(extractor)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := extr.(plugins.Discoverer)
+	if !ok {
+		return nil, fmt.Errorf("extractor %q does not support discovery, supported: %s", extractor, strings.Join(Discoverable(), ", "))
+	}
+
+	partitions, err := d.Discover(ctx, plugins.Config{RawConfig: config})
+	if err != nil {
+		return nil, fmt.Errorf("discover %s: %w", extractor, err)
+	}
+	data := make([]TemplateData, 0, len(partitions))
+	for _, p := range partitions {
+		data = append(data, TemplateData{
+			FileName: extractor + "-" + strings.Trim(unsafeFileName.ReplaceAllString(strings.ToLower(p.Name), "-"), "-"),
+			Data:     p.Data,
+		})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].FileName < data[j].FileName
+	})
+	return data, nil
+}
+
+// Discoverable lists the extractors that support discovery.
+func Discoverable() []string {
+	var names []string
+	for name := range registry.Extractors.List() {
+		extr, err := registry.Extractors.Get(name)
+		if err != nil {
+			continue
+		}
+		if _, ok := extr.(plugins.Discoverer); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
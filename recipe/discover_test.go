@@ -0,0 +1,89 @@
+package recipe_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/recipe"
+	"github.com/raystack/meteor/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type discoveringExtractor struct {
+	builderExtractor
+	partitions []plugins.Partition
+}
+
+func (e *discoveringExtractor) Discover(_ context.Context, config plugins.Config) ([]plugins.Partition, error) {
+	if config.RawConfig["token"] != "secret" {
+		return nil, plugins.InvalidConfigError{}
+	}
+	return e.partitions, nil
+}
+
+func TestDiscover(t *testing.T) {
+	require.NoError(t, registry.Extractors.Register("discovering-extractor", func() plugins.Extractor {
+		return &discoveringExtractor{partitions: []plugins.Partition{
+			{Name: "Sales DB", Data: map[string]any{"database": "Sales DB"}},
+			{Name: "analytics", Data: map[string]any{"database": "analytics"}},
+		}}
+	}))
+	require.NoError(t, registry.Extractors.Register("plain-extractor", newBuilderExtractor))
+
+	t.Run("should return template data sorted by file name", func(t *testing.T) {
+		data, err := recipe.Discover(context.Background(), "discovering-extractor", map[string]any{"token": "secret"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []recipe.TemplateData{
+			{FileName: "discovering-extractor-analytics", Data: map[string]any{"database": "analytics"}},
+			{FileName: "discovering-extractor-sales-db", Data: map[string]any{"database": "Sales DB"}},
+		}, data)
+	})
+
+	t.Run("should generate a recipe per partition", func(t *testing.T) {
+		data, err := recipe.Discover(context.Background(), "discovering-extractor", map[string]any{"token": "secret"})
+		require.NoError(t, err)
+
+		dir := t.TempDir()
+		tmpl := filepath.Join(dir, "template.yaml")
+		require.NoError(t, os.WriteFile(tmpl, []byte("name: {{ .Data.name }}\ndatabase: {{ .Data.database }}\n"), 0o600))
+		require.NoError(t, recipe.FromTemplate(recipe.TemplateConfig{
+			TemplateFilePath: tmpl,
+			OutputDirPath:    dir,
+			Data:             data,
+		}))
+
+		b, err := os.ReadFile(filepath.Join(dir, "discovering-extractor-sales-db.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "name: discovering-extractor-sales-db\ndatabase: Sales DB\n", string(b))
+	})
+
+	t.Run("should return error from the extractor", func(t *testing.T) {
+		_, err := recipe.Discover(context.Background(), "discovering-extractor", nil)
+		assert.ErrorAs(t, err, &plugins.InvalidConfigError{})
+	})
+
+	t.Run("should return error for extractors without discovery", func(t *testing.T) {
+		_, err := recipe.Discover(context.Background(), "plain-extractor", nil)
+		assert.ErrorContains(t, err, `extractor "plain-extractor" does not support discovery, supported: discovering-extractor`)
+	})
+}
+
+func TestSetConfigValue(t *testing.T) {
+	config := map[string]any{}
+	require.NoError(t, recipe.SetConfigValue(config, "token", "secret"))
+	require.NoError(t, recipe.SetConfigValue(config, "auth.tls.enabled", "true"))
+	require.NoError(t, recipe.SetConfigValue(config, "brokers", "[a:9092, b:9092]"))
+
+	assert.Equal(t, map[string]any{
+		"token":   "secret",
+		"auth":    map[string]any{"tls": map[string]any{"enabled": true}},
+		"brokers": []any{"a:9092", "b:9092"},
+	}, config)
+
+	assert.Error(t, recipe.SetConfigValue(config, "token.value", "x"))
+}
@@ -10,9 +10,9 @@ import (
 
 var recipeEnvVarPrefix = "METEOR_"
 
-func populateData(pathToConfig string) map[string]string {
+func populateData(pathToConfig string) map[string]any {
 	viper.AddConfigPath(".")
-	data := make(map[string]string)
+	data := make(map[string]any)
 
 	if pathToConfig != "" {
 		data = loadDataFromYaml(pathToConfig)
@@ -61,8 +61,11 @@ func mapToMeteorKey(rawKey string) (string, bool) {
 	return "", false
 }
 
-func loadDataFromYaml(path string) map[string]string {
-	data := make(map[string]string)
+// loadDataFromYaml reads variables from a yaml file, keeping their types.
+// Nested keys are flattened with underscores, so that source.port becomes
+// source_port, and top level maps and lists are also available as is.
+func loadDataFromYaml(path string) map[string]any {
+	data := make(map[string]any)
 	splitPath := strings.Split(path, "/")
 	fileName := strings.Split(splitPath[len(splitPath)-1], ".")
 	st := 0
@@ -73,7 +76,7 @@ func loadDataFromYaml(path string) map[string]string {
 	// set config details for Viper
 	viper.SetConfigName(fileName[0])
 	viper.SetConfigType(fileName[1])
-	viper.AddConfigPath(strings.Join(splitPath[st:len(splitPath)-1], ""))
+	viper.AddConfigPath(strings.Join(splitPath[st:len(splitPath)-1], "/"))
 	if err := viper.ReadInConfig(); err != nil {
 		log.Fatal(err)
 	}
@@ -81,7 +84,12 @@ func loadDataFromYaml(path string) map[string]string {
 	keys := viper.AllKeys()
 	for i := range keys {
 		varConvention := strings.Join(strings.Split(keys[i], "."), "_")
-		data[varConvention] = viper.GetString(keys[i])
+		data[varConvention] = viper.Get(keys[i])
+	}
+	for key, val := range viper.AllSettings() {
+		if _, ok := data[key]; !ok {
+			data[key] = val
+		}
 	}
 
 	return data
@@ -0,0 +1,68 @@
+package recipe
+
+import (
+	"regexp"
+	"strings"
+)
+
+// glob matches slash separated paths relative to a recipe directory.
+// '*' matches within a path segment, '?' matches one character other
+// than '/', and '**' matches any number of segments. A pattern without
+// a '/' is matched against the file name only.
+type glob struct {
+	baseOnly bool
+	re       *regexp.Regexp
+}
+
+func compileGlob(pattern string) glob {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// "**/" also matches no directory at all
+					i++
+					b.WriteString("(.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+				continue
+			}
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	return glob{baseOnly: !strings.Contains(pattern, "/"), re: regexp.MustCompile(b.String())}
+}
+
+func (g glob) match(rel string) bool {
+	if g.baseOnly {
+		rel = rel[strings.LastIndex(rel, "/")+1:]
+	}
+	return g.re.MatchString(rel)
+}
+
+func compileGlobs(patterns []string) []glob {
+	globs := make([]glob, 0, len(patterns))
+	for _, p := range patterns {
+		globs = append(globs, compileGlob(p))
+	}
+	return globs
+}
+
+func matchAny(globs []glob, rel string) bool {
+	for _, g := range globs {
+		if g.match(rel) {
+			return true
+		}
+	}
+	return false
+}
@@ -10,7 +10,9 @@ import (
 type RecipeNode struct {
 	Name       yaml.Node    `json:"name" yaml:"name"`
 	Version    yaml.Node    `json:"version" yaml:"version"`
+	Labels     yaml.Node    `json:"labels" yaml:"labels"`
 	Source     PluginNode   `json:"source" yaml:"source"`
+	Sources    []PluginNode `json:"sources" yaml:"sources"`
 	Sinks      []PluginNode `json:"sinks" yaml:"sinks"`
 	Processors []PluginNode `json:"processors" yaml:"processors"`
 }
@@ -22,6 +24,8 @@ type PluginNode struct {
 	Type   yaml.Node            `json:"type" yaml:"type"`
 	Scope  yaml.Node            `json:"scope" yaml:"scope"`
 	Config map[string]yaml.Node `json:"config" yaml:"config"`
+	When   yaml.Node            `json:"when" yaml:"when"`
+	Match  yaml.Node            `json:"match" yaml:"match"`
 }
 
 // decodeConfig decodes the plugins config
@@ -41,14 +45,9 @@ func (plug PluginNode) decodeConfig() (map[string]any, error) {
 
 // toRecipe passes the value from RecipeNode to Recipe
 func (node RecipeNode) toRecipe() (Recipe, error) {
-	// It supports both tags `name` and `type` for source
-	// till `type` tag gets deprecated
-	if node.Source.Name.IsZero() {
-		node.Source.Name = node.Source.Type
-	}
-	sourceConfig, err := node.Source.decodeConfig()
+	sources, err := node.toSources()
 	if err != nil {
-		return Recipe{}, fmt.Errorf("decode source config :%w", err)
+		return Recipe{}, err
 	}
 
 	processors, err := node.toProcessors()
@@ -61,21 +60,60 @@ func (node RecipeNode) toRecipe() (Recipe, error) {
 		return Recipe{}, fmt.Errorf("build sinks :%w", err)
 	}
 
+	var labels map[string]string
+	if !node.Labels.IsZero() {
+		if err := node.Labels.Decode(&labels); err != nil {
+			return Recipe{}, fmt.Errorf("decode labels :%w", err)
+		}
+	}
+
 	return Recipe{
-		Name:    node.Name.Value,
-		Version: node.Version.Value,
-		Source: PluginRecipe{
-			Name:   node.Source.Name.Value,
-			Scope:  node.Source.Scope.Value,
-			Config: sourceConfig,
-			Node:   node.Source,
-		},
+		Name:       node.Name.Value,
+		Version:    node.Version.Value,
+		Labels:     labels,
+		Source:     sources[0],
+		Sources:    sources,
 		Sinks:      sinks,
 		Processors: processors,
 		Node:       node,
 	}, nil
 }
 
+// toSources passes the value of source PluginNode, or of each PluginNode
+// in sources, to its PluginRecipe
+func (node RecipeNode) toSources() ([]PluginRecipe, error) {
+	nodes := node.Sources
+	if len(nodes) == 0 {
+		nodes = []PluginNode{node.Source}
+	} else if !node.Source.Name.IsZero() || !node.Source.Type.IsZero() {
+		return nil, fmt.Errorf("recipe cannot have both source and sources")
+	}
+
+	sources := make([]PluginRecipe, 0, len(nodes))
+	for i, source := range nodes {
+		// It supports both tags `name` and `type` for source
+		// till `type` tag gets deprecated
+		if source.Name.IsZero() {
+			source.Name = source.Type
+		}
+		sourceConfig, err := source.decodeConfig()
+		if err != nil {
+			if len(node.Sources) > 0 {
+				return nil, fmt.Errorf("decode config of source %d :%w", i, err)
+			}
+			return nil, fmt.Errorf("decode source config :%w", err)
+		}
+
+		sources = append(sources, PluginRecipe{
+			Name:   source.Name.Value,
+			Scope:  source.Scope.Value,
+			Config: sourceConfig,
+			Node:   source,
+		})
+	}
+	return sources, nil
+}
+
 // toProcessors passes the value of processor PluginNode to its PluginRecipe
 func (node RecipeNode) toProcessors() ([]PluginRecipe, error) {
 	var processors []PluginRecipe
@@ -84,10 +122,15 @@ func (node RecipeNode) toProcessors() ([]PluginRecipe, error) {
 		if err != nil {
 			return nil, fmt.Errorf("decode processor config :%w", err)
 		}
+		match, err := decodeRecordSelector(processor.Match)
+		if err != nil {
+			return nil, fmt.Errorf("decode match of processor %q: %w", processor.Name.Value, err)
+		}
 
 		processors = append(processors, PluginRecipe{
 			Name:   processor.Name.Value,
 			Config: processorConfig,
+			Match:  match,
 			Node:   processor,
 		})
 	}
@@ -102,10 +145,15 @@ func (node RecipeNode) toSinks() ([]PluginRecipe, error) {
 		if err != nil {
 			return nil, fmt.Errorf("decode sink config :%w", err)
 		}
+		when, err := decodeRecordSelector(sink.When)
+		if err != nil {
+			return nil, fmt.Errorf("decode when of sink %q: %w", sink.Name.Value, err)
+		}
 
 		sinks = append(sinks, PluginRecipe{
 			Name:   sink.Name.Value,
 			Config: sinkConfig,
+			When:   when,
 			Node:   sink,
 		})
 	}
@@ -1,12 +1,12 @@
 package recipe
 
 import (
-	"bytes"
+	"context"
 	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
-	"text/template"
 
 	log "github.com/raystack/salt/observability/logger"
 	"gopkg.in/yaml.v3"
@@ -14,36 +14,109 @@ import (
 
 // Reader is a struct that reads recipe files.
 type Reader struct {
-	data map[string]string
-	log  log.Logger
+	data     map[string]any
+	log      log.Logger
+	fetcher  *Fetcher
+	include  []string
+	exclude  []string
+	selector Selector
+	skipped  []SkippedFile
+}
+
+// SkippedFile is a file in a recipe directory that could not be read.
+type SkippedFile struct {
+	Path string
+	Err  error
+}
+
+// ReaderOption configures a Reader.
+type ReaderOption func(*Reader)
+
+// WithFetcher sets the Fetcher used for remote recipe locations.
+func WithFetcher(f *Fetcher) ReaderOption {
+	return func(r *Reader) {
+		r.fetcher = f
+	}
+}
+
+// WithInclude only reads the files of a recipe directory matching one of
+// the glob patterns.
+func WithInclude(patterns ...string) ReaderOption {
+	return func(r *Reader) {
+		r.include = append(r.include, patterns...)
+	}
+}
+
+// WithExclude skips the files of a recipe directory matching one of the
+// glob patterns.
+func WithExclude(patterns ...string) ReaderOption {
+	return func(r *Reader) {
+		r.exclude = append(r.exclude, patterns...)
+	}
+}
+
+// WithSelector only returns the recipes whose labels match sel.
+func WithSelector(sel Selector) ReaderOption {
+	return func(r *Reader) {
+		r.selector = sel
+	}
 }
 
 var ErrInvalidRecipeVersion = errors.New("recipe version is invalid or not found")
 
 // NewReader returns a new Reader.
-func NewReader(lg log.Logger, pathToConfig string) *Reader {
+func NewReader(lg log.Logger, pathToConfig string, opts ...ReaderOption) *Reader {
 	reader := &Reader{}
 	reader.data = populateData(pathToConfig)
 	reader.log = lg
+	reader.fetcher = &Fetcher{}
+	for _, opt := range opts {
+		opt(reader)
+	}
 	return reader
 }
 
-// Read loads the list of recipes from a give file or directory path.
-func (r *Reader) Read(path string) ([]Recipe, error) {
+// Skipped returns the files of a recipe directory that could not be read
+// during the last call to Read.
+func (r *Reader) Skipped() []SkippedFile {
+	return r.skipped
+}
+
+// Resolve returns the local path of a recipe location, fetching remote
+// locations into the cache first. Local paths are returned unchanged.
+func (r *Reader) Resolve(location string) (string, error) {
+	if !IsRemote(location) {
+		return location, nil
+	}
+	return r.fetcher.Fetch(context.Background(), location)
+}
+
+// Read loads the list of recipes from a give file or directory path, or
+// from a remote location accepted by Fetcher.
+func (r *Reader) Read(location string) ([]Recipe, error) {
+	path, err := r.Resolve(location)
+	if err != nil {
+		return nil, err
+	}
+
 	fi, err := os.Stat(path)
 	if err != nil {
 		return nil, err
 	}
 
+	r.skipped = nil
 	switch mode := fi.Mode(); {
 	case mode.IsDir():
-		return r.readDir(r.log, path)
+		return r.readDir(path)
 
 	case mode.IsRegular():
 		recipe, err := r.readFile(path)
 		if err != nil {
 			return nil, err
 		}
+		if !r.selector.Matches(recipe.Labels) {
+			return nil, nil
+		}
 
 		return []Recipe{recipe}, nil
 	}
@@ -51,19 +124,19 @@ func (r *Reader) Read(path string) ([]Recipe, error) {
 	return nil, nil
 }
 
+// Render returns the recipe file at path with its template executed.
+func (r *Reader) Render(path string) ([]byte, error) {
+	return renderTemplate(path, r.data)
+}
+
 func (r *Reader) readFile(path string) (Recipe, error) {
-	tmpl, err := template.ParseFiles(path)
+	b, err := r.Render(path)
 	if err != nil {
 		return Recipe{}, err
 	}
 
-	var buff bytes.Buffer
-	if err := tmpl.Execute(&buff, r.data); err != nil {
-		return Recipe{}, err
-	}
-
 	var node RecipeNode
-	if err := yaml.Unmarshal(buff.Bytes(), &node); err != nil {
+	if err := yaml.Unmarshal(b, &node); err != nil {
 		return Recipe{}, err
 	}
 
@@ -87,22 +160,76 @@ func (r *Reader) readFile(path string) (Recipe, error) {
 	return recipe, nil
 }
 
-func (r *Reader) readDir(lg log.Logger, path string) ([]Recipe, error) {
-	entries, err := os.ReadDir(path)
+// Files returns the recipe files at path: path itself when it is a file,
+// or the files Read reads when it is a directory.
+func (r *Reader) Files(path string) ([]string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{path}, nil
+	}
+	return r.dirFiles(path)
+}
+
+// dirFiles returns the YAML files below root matching the include and
+// exclude globs, descending into directories other than hidden ones.
+func (r *Reader) dirFiles(root string) ([]string, error) {
+	include, exclude := compileGlobs(r.include), compileGlobs(r.exclude)
+
+	var paths []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if (len(include) > 0 && !matchAny(include, rel)) || matchAny(exclude, rel) {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// readDir reads the recipe files below root. Files that cannot be read are
+// recorded as skipped.
+func (r *Reader) readDir(root string) ([]Recipe, error) {
+	paths, err := r.dirFiles(root)
 	if err != nil {
 		return nil, err
 	}
 
 	var recipes []Recipe
-	for _, entry := range entries {
-		x := filepath.Join(path, entry.Name())
-		recipe, err := r.readFile(x)
+	for _, path := range paths {
+		recipe, err := r.readFile(path)
 		if err != nil {
-			lg.Warn("skipping file", "path", x, "err", err.Error())
+			r.log.Debug("skipping file", "path", path, "err", err.Error())
+			r.skipped = append(r.skipped, SkippedFile{Path: path, Err: err})
 			continue
 		}
-
-		recipes = append(recipes, recipe)
+		if r.selector.Matches(recipe.Labels) {
+			recipes = append(recipes, recipe)
+		}
 	}
 
 	return recipes, nil
@@ -1,20 +1,36 @@
 package recipe
 
-// Recipe contains the json data for a recipe
+// Recipe contains the json data for a recipe. Sources holds every source
+// of the recipe, given either as source or as a list in sources, and Source
+// is the first of them.
 type Recipe struct {
-	Name       string         `json:"name" yaml:"name" validate:"required"`
-	Version    string         `json:"version" yaml:"version" validate:"required"`
-	Source     PluginRecipe   `json:"source" yaml:"source" validate:"required"`
-	Sinks      []PluginRecipe `json:"sinks" yaml:"sinks" validate:"required,min=1"`
-	Processors []PluginRecipe `json:"processors" yaml:"processors"`
+	Name       string            `json:"name" yaml:"name" validate:"required"`
+	Version    string            `json:"version" yaml:"version" validate:"required"`
+	Labels     map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Source     PluginRecipe      `json:"source" yaml:"source" validate:"required"`
+	Sources    []PluginRecipe    `json:"sources,omitempty" yaml:"sources,omitempty"`
+	Sinks      []PluginRecipe    `json:"sinks" yaml:"sinks" validate:"required,min=1"`
+	Processors []PluginRecipe    `json:"processors" yaml:"processors"`
 	Node       RecipeNode
 }
 
 // PluginRecipe contains the json data for a recipe that is being used for
-// generating the plugins code for a recipe.
+// generating the plugins code for a recipe. When selects the records a sink
+// receives and Match the records a processor processes.
 type PluginRecipe struct {
 	Name   string         `json:"name" yaml:"name" validate:"required"`
 	Scope  string         `json:"scope" yaml:"scope"`
 	Config map[string]any `json:"config" yaml:"config"`
+	When   RecordSelector `json:"when,omitempty" yaml:"when,omitempty"`
+	Match  RecordSelector `json:"match,omitempty" yaml:"match,omitempty"`
 	Node   PluginNode
 }
+
+// AllSources returns the sources of the recipe. Recipes that are not read
+// from a file may only set Source.
+func (r Recipe) AllSources() []PluginRecipe {
+	if len(r.Sources) > 0 {
+		return r.Sources
+	}
+	return []PluginRecipe{r.Source}
+}
@@ -0,0 +1,50 @@
+package recipe
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RecordSelector selects records by their entity. A record is selected when it
+// matches every field that is set, so an empty selector selects all records.
+type RecordSelector struct {
+	// Types are entity types, one of which the entity must have.
+	Types []string `json:"types,omitempty" yaml:"types,omitempty"`
+	// URNs are glob patterns, one of which the entity URN must match.
+	URNs []string `json:"urns,omitempty" yaml:"urns,omitempty"`
+	// Sources are services, such as bigquery, one of which the entity
+	// must come from.
+	Sources []string `json:"sources,omitempty" yaml:"sources,omitempty"`
+	// Properties maps dotted paths into the entity properties to glob
+	// patterns their values must match.
+	Properties map[string]string `json:"properties,omitempty" yaml:"properties,omitempty"`
+}
+
+// IsZero reports whether the selector selects all records.
+func (s RecordSelector) IsZero() bool {
+	return len(s.Types) == 0 && len(s.URNs) == 0 && len(s.Sources) == 0 && len(s.Properties) == 0
+}
+
+var selectorKeys = map[string]bool{"types": true, "urns": true, "sources": true, "properties": true}
+
+// decodeRecordSelector decodes a selector, failing on unknown keys so that a
+// misspelt key does not select every record.
+func decodeRecordSelector(node yaml.Node) (RecordSelector, error) {
+	var s RecordSelector
+	if node.IsZero() {
+		return s, nil
+	}
+	if node.Kind != yaml.MappingNode {
+		return s, fmt.Errorf("line %d: expected a mapping", node.Line)
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if key := node.Content[i]; !selectorKeys[key.Value] {
+			return s, fmt.Errorf("line %d: unknown key %q, expected types, urns, sources or properties", key.Line, key.Value)
+		}
+	}
+	if err := node.Decode(&s); err != nil {
+		return s, err
+	}
+	return s, nil
+}
@@ -0,0 +1,500 @@
+package recipe
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"google.golang.org/api/iterator"
+)
+
+// ErrChecksumMismatch is returned when fetched recipes do not match the
+// checksum pinned in their location.
+var ErrChecksumMismatch = errors.New("recipe checksum mismatch")
+
+const defaultFetchTimeout = 2 * time.Minute
+
+var commitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// IsRemote reports whether location points to recipes that have to be
+// fetched before they can be read.
+func IsRemote(location string) bool {
+	for _, prefix := range []string{"https://", "git::", "gs://", "s3://"} {
+		if strings.HasPrefix(location, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fetcher downloads remote recipe locations into a local cache.
+//
+// Supported locations are
+//
+//	https://example.com/recipes/postgres.yaml
+//	git::https://github.com/org/recipes.git//path/in/repo?ref=v1.2.0
+//	gs://bucket/recipes/ and gs://bucket/recipes/postgres.yaml
+//	s3://bucket/recipes/ and s3://bucket/recipes/postgres.yaml
+//
+// Any location may pin its content with checksum=sha256:<hex> in the query.
+// For a single file it is the sha256 of the file, for a directory it is the
+// checksum printed by ChecksumDir. A pinned location already in the cache is
+// not fetched again, nor is a git location whose ref is a full commit SHA.
+type Fetcher struct {
+	// CacheDir is where fetched recipes are kept. It defaults to
+	// meteor/recipes in the user cache directory.
+	CacheDir   string
+	HTTPClient *http.Client
+	Timeout    time.Duration
+}
+
+// location is a parsed remote recipe location.
+type location struct {
+	raw      string
+	scheme   string
+	url      *url.URL
+	repo     string
+	ref      string
+	subpath  string
+	checksum string
+}
+
+func parseLocation(raw string) (location, error) {
+	loc := location{raw: raw}
+
+	s := raw
+	if rest, ok := strings.CutPrefix(raw, "git::"); ok {
+		loc.scheme = "git"
+		s = rest
+	}
+
+	var query url.Values
+	if i := strings.LastIndex(s, "?"); i >= 0 {
+		q, err := url.ParseQuery(s[i+1:])
+		if err != nil {
+			return loc, fmt.Errorf("parse recipe location %q: %w", raw, err)
+		}
+		query, s = q, s[:i]
+	}
+	if sum := query.Get("checksum"); sum != "" {
+		hexSum, ok := strings.CutPrefix(sum, "sha256:")
+		if !ok {
+			return loc, fmt.Errorf("parse recipe location %q: checksum must be sha256:<hex>", raw)
+		}
+		loc.checksum = strings.ToLower(hexSum)
+		query.Del("checksum")
+	}
+
+	if loc.scheme == "git" {
+		loc.ref = query.Get("ref")
+		start := 0
+		if i := strings.Index(s, "://"); i >= 0 {
+			start = i + len("://")
+		}
+		if i := strings.Index(s[start:], "//"); i >= 0 {
+			s, loc.subpath = s[:start+i], strings.Trim(s[start+i+2:], "/")
+		}
+		if s == "" {
+			return loc, fmt.Errorf("parse recipe location %q: missing repository", raw)
+		}
+		// git would read a repository or ref starting with - as an option.
+		if strings.HasPrefix(s, "-") || strings.HasPrefix(loc.ref, "-") {
+			return loc, fmt.Errorf("parse recipe location %q: repository and ref must not start with -", raw)
+		}
+		if !within("repo", filepath.Join("repo", filepath.FromSlash(loc.subpath))) {
+			return loc, fmt.Errorf("parse recipe location %q: path is outside of the repository", raw)
+		}
+		loc.repo = s
+		return loc, nil
+	}
+
+	if len(query) > 0 {
+		s += "?" + query.Encode()
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return loc, fmt.Errorf("parse recipe location %q: %w", raw, err)
+	}
+	switch u.Scheme {
+	case "https", "gs", "s3":
+	default:
+		return loc, fmt.Errorf("parse recipe location %q: unsupported scheme %q", raw, u.Scheme)
+	}
+	if u.Host == "" {
+		return loc, fmt.Errorf("parse recipe location %q: missing host or bucket", raw)
+	}
+	loc.scheme, loc.url = u.Scheme, u
+	return loc, nil
+}
+
+// Fetch downloads location into the cache and returns the local path of
+// the recipe file or directory.
+func (f *Fetcher) Fetch(ctx context.Context, raw string) (string, error) {
+	loc, err := parseLocation(raw)
+	if err != nil {
+		return "", err
+	}
+
+	cacheDir, err := f.cacheDir()
+	if err != nil {
+		return "", err
+	}
+	key := sha256.Sum256([]byte(loc.raw))
+	dest := filepath.Join(cacheDir, hex.EncodeToString(key[:8]))
+	target := filepath.Join(dest, loc.target())
+
+	if f.reusable(loc, target) {
+		return target, nil
+	}
+
+	timeout := f.Timeout
+	if timeout == 0 {
+		timeout = defaultFetchTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tmp, err := os.MkdirTemp(cacheDir, ".fetch-")
+	if err != nil {
+		return "", fmt.Errorf("fetch %s: %w", raw, err)
+	}
+	defer os.RemoveAll(tmp)
+
+	switch loc.scheme {
+	case "https":
+		err = f.fetchHTTP(ctx, loc, tmp)
+	case "git":
+		err = fetchGit(ctx, loc, tmp)
+	case "gs":
+		err = fetchGCS(ctx, loc, tmp)
+	case "s3":
+		err = fetchS3(ctx, loc, tmp)
+	}
+	if err != nil {
+		return "", fmt.Errorf("fetch %s: %w", raw, err)
+	}
+
+	fetched := filepath.Join(tmp, loc.target())
+	if _, err := os.Stat(fetched); err != nil {
+		return "", fmt.Errorf("fetch %s: %w", raw, err)
+	}
+	if loc.checksum != "" {
+		sum, err := checksum(fetched)
+		if err != nil {
+			return "", fmt.Errorf("fetch %s: %w", raw, err)
+		}
+		if sum != loc.checksum {
+			return "", fmt.Errorf("fetch %s: %w: expected sha256:%s, got sha256:%s", raw, ErrChecksumMismatch, loc.checksum, sum)
+		}
+	}
+
+	if err := os.RemoveAll(dest); err != nil {
+		return "", fmt.Errorf("fetch %s: %w", raw, err)
+	}
+	if err := os.Rename(tmp, dest); err != nil {
+		return "", fmt.Errorf("fetch %s: %w", raw, err)
+	}
+	return target, nil
+}
+
+// target is the path of the recipes relative to the fetch directory.
+func (l location) target() string {
+	switch l.scheme {
+	case "git":
+		return filepath.Join("repo", filepath.FromSlash(l.subpath))
+	case "https":
+		return path.Base(l.url.Path)
+	default:
+		if strings.HasSuffix(l.url.Path, "/") || l.url.Path == "" {
+			return "objects"
+		}
+		return path.Base(l.url.Path)
+	}
+}
+
+// reusable reports whether the cached copy at target can be used as is.
+func (f *Fetcher) reusable(loc location, target string) bool {
+	if _, err := os.Stat(target); err != nil {
+		return false
+	}
+	if loc.checksum != "" {
+		sum, err := checksum(target)
+		return err == nil && sum == loc.checksum
+	}
+	return loc.scheme == "git" && commitSHA.MatchString(loc.ref)
+}
+
+func (f *Fetcher) cacheDir() (string, error) {
+	dir := f.CacheDir
+	if dir == "" {
+		userCache, err := os.UserCacheDir()
+		if err != nil {
+			return "", fmt.Errorf("recipe cache: %w", err)
+		}
+		dir = filepath.Join(userCache, "meteor", "recipes")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("recipe cache: %w", err)
+	}
+	return dir, nil
+}
+
+func (f *Fetcher) fetchHTTP(ctx context.Context, loc location, dir string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, loc.url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	client := f.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return writeFile(filepath.Join(dir, loc.target()), resp.Body)
+}
+
+func fetchGit(ctx context.Context, loc location, dir string) error {
+	repoDir := filepath.Join(dir, "repo")
+	ref := loc.ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	for _, args := range [][]string{
+		{"init", "--quiet", repoDir},
+		{"fetch", "--quiet", "--depth", "1", "--", loc.repo, ref},
+		{"-c", "advice.detachedHead=false", "checkout", "--quiet", "FETCH_HEAD"},
+	} {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = dir
+		if args[0] != "init" {
+			cmd.Dir = repoDir
+		}
+		cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+		}
+	}
+
+	return os.RemoveAll(filepath.Join(repoDir, ".git"))
+}
+
+func fetchGCS(ctx context.Context, loc location, dir string) error {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("create gcs client: %w", err)
+	}
+	defer client.Close()
+
+	bucket := client.Bucket(loc.url.Host)
+	key := strings.TrimPrefix(loc.url.Path, "/")
+	if loc.target() != "objects" {
+		return readGCSObject(ctx, bucket, key, filepath.Join(dir, loc.target()))
+	}
+
+	it := bucket.Objects(ctx, &storage.Query{Prefix: key})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("list gcs objects: %w", err)
+		}
+		if strings.HasSuffix(attrs.Name, "/") {
+			continue
+		}
+		dest, err := objectPath(dir, key, attrs.Name)
+		if err != nil {
+			return err
+		}
+		if err := readGCSObject(ctx, bucket, attrs.Name, dest); err != nil {
+			return err
+		}
+	}
+}
+
+func readGCSObject(ctx context.Context, bucket *storage.BucketHandle, name, dest string) error {
+	r, err := bucket.Object(name).NewReader(ctx)
+	if err != nil {
+		return fmt.Errorf("read gcs object %s: %w", name, err)
+	}
+	defer r.Close()
+
+	return writeFile(dest, r)
+}
+
+func fetchS3(ctx context.Context, loc location, dir string) error {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("load AWS config: %w", err)
+	}
+	client := s3.NewFromConfig(cfg)
+
+	bucket := loc.url.Host
+	key := strings.TrimPrefix(loc.url.Path, "/")
+	if loc.target() != "objects" {
+		return readS3Object(ctx, client, bucket, key, filepath.Join(dir, loc.target()))
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("list s3 objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			name := aws.ToString(obj.Key)
+			if strings.HasSuffix(name, "/") {
+				continue
+			}
+			dest, err := objectPath(dir, key, name)
+			if err != nil {
+				return err
+			}
+			if err := readS3Object(ctx, client, bucket, name, dest); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readS3Object(ctx context.Context, client *s3.Client, bucket, key, dest string) error {
+	out, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("read s3 object %s: %w", key, err)
+	}
+	defer out.Body.Close()
+
+	return writeFile(dest, out.Body)
+}
+
+// objectPath returns the path in dir the object name listed under prefix is
+// written to. Names leaving dir, such as prefix/../../x, are refused.
+func objectPath(dir, prefix, name string) (string, error) {
+	root := filepath.Join(dir, "objects")
+	dest := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(name, prefix)))
+	if !within(root, dest) {
+		return "", fmt.Errorf("object %s: path is outside of %s", name, prefix)
+	}
+	return dest, nil
+}
+
+// within reports whether path is root or a path under it.
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func writeFile(dest string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Checksum returns the checksum used to pin the recipes at a local path:
+// the sha256 of a file, or ChecksumDir of a directory.
+func Checksum(path string) (string, error) {
+	return checksum(path)
+}
+
+func checksum(path string) (string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return ChecksumDir(path)
+	}
+	return fileChecksum(path)
+}
+
+// ChecksumDir returns the checksum used to pin a directory of recipes: the
+// sha256 of one "<sha256 of file>  <slash separated path>" line per file,
+// sorted by path.
+func ChecksumDir(dir string) (string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, p := range files {
+		sum, err := fileChecksum(p)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s  %s\n", sum, filepath.ToSlash(rel))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,218 @@
+package recipe_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/raystack/meteor/recipe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRemote(t *testing.T) {
+	for location, expected := range map[string]bool{
+		"https://example.com/recipe.yaml":         true,
+		"git::https://github.com/org/repo.git//r": true,
+		"gs://bucket/recipes/":                    true,
+		"s3://bucket/recipe.yaml":                 true,
+		"http://example.com/recipe.yaml":          false,
+		"./recipes":                               false,
+		"/etc/meteor/recipe.yaml":                 false,
+	} {
+		assert.Equal(t, expected, recipe.IsRemote(location), location)
+	}
+}
+
+func TestReaderReadHTTPS(t *testing.T) {
+	content, err := os.ReadFile("testdata/testdir/test-recipe.yaml")
+	require.NoError(t, err)
+	sum := sha256.Sum256(content)
+
+	var hits int
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/recipes/test-recipe.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		hits++
+		_, _ = w.Write(content)
+	}))
+	defer srv.Close()
+
+	newReader := func(cacheDir string) *recipe.Reader {
+		return recipe.NewReader(testLog, emptyConfigPath, recipe.WithFetcher(&recipe.Fetcher{
+			CacheDir:   cacheDir,
+			HTTPClient: srv.Client(),
+		}))
+	}
+
+	t.Run("should read recipe from url", func(t *testing.T) {
+		recipes, err := newReader(t.TempDir()).Read(srv.URL + "/recipes/test-recipe.yaml")
+		require.NoError(t, err)
+		require.Len(t, recipes, 1)
+		assert.Equal(t, "test-recipe", recipes[0].Name)
+		assert.Equal(t, "test-source", recipes[0].Source.Name)
+	})
+
+	t.Run("should reuse cached recipe when checksum is pinned", func(t *testing.T) {
+		hits = 0
+		reader := newReader(t.TempDir())
+		location := srv.URL + "/recipes/test-recipe.yaml?checksum=sha256:" + hex.EncodeToString(sum[:])
+
+		for i := 0; i < 2; i++ {
+			recipes, err := reader.Read(location)
+			require.NoError(t, err)
+			assert.Len(t, recipes, 1)
+		}
+		assert.Equal(t, 1, hits)
+	})
+
+	t.Run("should return error on checksum mismatch", func(t *testing.T) {
+		_, err := newReader(t.TempDir()).Read(srv.URL + "/recipes/test-recipe.yaml?checksum=sha256:" + strings.Repeat("0", 64))
+		assert.ErrorIs(t, err, recipe.ErrChecksumMismatch)
+	})
+
+	t.Run("should return error on unexpected status", func(t *testing.T) {
+		_, err := newReader(t.TempDir()).Read(srv.URL + "/recipes/missing.yaml")
+		assert.ErrorContains(t, err, "404 Not Found")
+	})
+}
+
+func TestReaderReadGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=meteor", "GIT_AUTHOR_EMAIL=meteor@example.com",
+			"GIT_COMMITTER_NAME=meteor", "GIT_COMMITTER_EMAIL=meteor@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	writeRecipe := func(name, scope string) {
+		t.Helper()
+		content, err := os.ReadFile("testdata/testdir/test-recipe.yaml")
+		require.NoError(t, err)
+		content = []byte(strings.Replace(string(content), "my-scope", scope, 1))
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, "recipes"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(repo, "recipes", name), content, 0o644))
+	}
+
+	git("init", "--quiet")
+	writeRecipe("a.yaml", "v1")
+	git("add", ".")
+	git("commit", "--quiet", "-m", "v1")
+	git("tag", "v1")
+	v1 := git("rev-parse", "HEAD")
+	writeRecipe("a.yaml", "v2")
+	writeRecipe("b.yaml", "v2")
+	git("add", ".")
+	git("commit", "--quiet", "-m", "v2")
+
+	reader := recipe.NewReader(testLog, emptyConfigPath, recipe.WithFetcher(&recipe.Fetcher{CacheDir: t.TempDir()}))
+
+	t.Run("should read directory at default branch", func(t *testing.T) {
+		recipes, err := reader.Read("git::" + repo + "//recipes")
+		require.NoError(t, err)
+		require.Len(t, recipes, 2)
+		assert.Equal(t, "v2", recipes[0].Source.Scope)
+	})
+
+	t.Run("should read file at tag", func(t *testing.T) {
+		recipes, err := reader.Read("git::" + repo + "//recipes/a.yaml?ref=v1")
+		require.NoError(t, err)
+		require.Len(t, recipes, 1)
+		assert.Equal(t, "v1", recipes[0].Source.Scope)
+	})
+
+	t.Run("should read directory at commit with pinned checksum", func(t *testing.T) {
+		path, err := reader.Resolve("git::" + repo + "//recipes?ref=" + v1)
+		require.NoError(t, err)
+		sum, err := recipe.ChecksumDir(path)
+		require.NoError(t, err)
+
+		recipes, err := reader.Read("git::" + repo + "//recipes?ref=" + v1 + "&checksum=sha256:" + sum)
+		require.NoError(t, err)
+		require.Len(t, recipes, 1)
+		assert.Equal(t, "v1", recipes[0].Source.Scope)
+
+		_, err = reader.Read("git::" + repo + "//recipes?checksum=sha256:" + sum)
+		assert.ErrorIs(t, err, recipe.ErrChecksumMismatch)
+	})
+
+	t.Run("should return error for unknown ref", func(t *testing.T) {
+		_, err := reader.Read("git::" + repo + "//recipes?ref=does-not-exist")
+		assert.ErrorContains(t, err, "git fetch")
+	})
+
+	t.Run("should refuse repository and ref read as git options", func(t *testing.T) {
+		marker := filepath.Join(t.TempDir(), "executed")
+		for _, location := range []string{
+			"git::--upload-pack=touch " + marker + "//recipes",
+			"git::" + repo + "//recipes?ref=--upload-pack=touch " + marker,
+		} {
+			_, err := reader.Read(location)
+			assert.ErrorContains(t, err, "repository and ref must not start with -", location)
+		}
+		assert.NoFileExists(t, marker)
+	})
+
+	t.Run("should refuse path outside of the repository", func(t *testing.T) {
+		_, err := reader.Read("git::" + repo + "//../../recipes")
+		assert.ErrorContains(t, err, "path is outside of the repository")
+	})
+}
+
+func TestReaderReadGCS(t *testing.T) {
+	// The storage client lists objects with the JSON API of the emulator
+	// host, and reads them with its XML API.
+	var objects []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/storage/v1/b/bucket/o" {
+			items := make([]map[string]any, 0, len(objects))
+			for _, name := range objects {
+				items = append(items, map[string]any{"kind": "storage#object", "bucket": "bucket", "name": name})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"kind": "storage#objects", "items": items})
+			return
+		}
+		content, err := os.ReadFile("testdata/testdir/test-recipe.yaml")
+		require.NoError(t, err)
+		_, _ = w.Write(content)
+	}))
+	defer srv.Close()
+	t.Setenv("STORAGE_EMULATOR_HOST", strings.TrimPrefix(srv.URL, "http://"))
+
+	t.Run("should read the objects under a prefix", func(t *testing.T) {
+		objects = []string{"recipes/", "recipes/nested/test-recipe.yaml"}
+		reader := recipe.NewReader(testLog, emptyConfigPath, recipe.WithFetcher(&recipe.Fetcher{CacheDir: t.TempDir()}))
+		recipes, err := reader.Read("gs://bucket/recipes/")
+		require.NoError(t, err)
+		require.Len(t, recipes, 1)
+		assert.Equal(t, "test-recipe", recipes[0].Name)
+	})
+
+	t.Run("should refuse objects outside of the prefix", func(t *testing.T) {
+		objects = []string{"recipes/../../escaped.yaml"}
+		cacheDir := filepath.Join(t.TempDir(), "cache")
+		reader := recipe.NewReader(testLog, emptyConfigPath, recipe.WithFetcher(&recipe.Fetcher{CacheDir: cacheDir}))
+		_, err := reader.Read("gs://bucket/recipes/")
+		assert.ErrorContains(t, err, "object recipes/../../escaped.yaml: path is outside of recipes/")
+		assert.NoFileExists(t, filepath.Join(cacheDir, "escaped.yaml"))
+	})
+}
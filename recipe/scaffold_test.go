@@ -3,13 +3,18 @@ package recipe_test
 import (
 	"bytes"
 	_ "embed"
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/raystack/meteor/plugins"
+	_ "github.com/raystack/meteor/plugins/processors" // populate processors registry
 	"github.com/raystack/meteor/recipe"
 	"github.com/raystack/meteor/registry"
 	"github.com/raystack/meteor/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var recipeVersions = [1]string{"v1beta1"}
@@ -177,6 +182,33 @@ func TestScaffoldWriteTo(t *testing.T) {
 	}
 }
 
+func TestScaffoldWriteToProcessors(t *testing.T) {
+	// Sample configs are written as they are into recipes, which are
+	// templates themselves, so they must be readable as recipes.
+	var names []string
+	for name := range registry.Processors.List() {
+		if name != "test-processor" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, recipe.ScaffoldWriteTo(recipe.ScaffoldParams{Name: "scaffolded", Processors: []string{name}}, &buf))
+			path := filepath.Join(t.TempDir(), "recipe.yaml")
+			require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+			recipes, err := recipe.NewReader(testLog, emptyConfigPath).Read(path)
+			require.NoError(t, err)
+			require.Len(t, recipes, 1)
+			require.Len(t, recipes[0].Processors, 1)
+			assert.Equal(t, name, recipes[0].Processors[0].Name)
+		})
+	}
+}
+
 func TestGetRecipeVersions(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,98 @@
+package recipe
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var labelKey = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+
+// Selector selects recipes by their labels. It is parsed from a comma
+// separated list of requirements, all of which must hold:
+//
+//	team=payments   label team is payments
+//	env!=dev        label env is missing or not dev
+//	critical        label critical is set
+//	!deprecated     label deprecated is not set
+type Selector []requirement
+
+type requirement struct {
+	key   string
+	op    string
+	value string
+}
+
+// ParseSelector parses a label selector such as "team=payments,env!=dev".
+// An empty string selects every recipe.
+func ParseSelector(s string) (Selector, error) {
+	var sel Selector
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var req requirement
+		switch {
+		case strings.Contains(part, "!="):
+			k, v, _ := strings.Cut(part, "!=")
+			req = requirement{key: k, op: "!=", value: v}
+		case strings.Contains(part, "="):
+			k, v, _ := strings.Cut(part, "=")
+			req = requirement{key: k, op: "=", value: strings.TrimPrefix(v, "=")}
+		case strings.HasPrefix(part, "!"):
+			req = requirement{key: strings.TrimPrefix(part, "!"), op: "!"}
+		default:
+			req = requirement{key: part, op: "exists"}
+		}
+
+		req.key, req.value = strings.TrimSpace(req.key), strings.TrimSpace(req.value)
+		if !labelKey.MatchString(req.key) {
+			return nil, fmt.Errorf("invalid selector %q: invalid label key %q", s, req.key)
+		}
+		sel = append(sel, req)
+	}
+	return sel, nil
+}
+
+// Matches reports whether labels satisfy every requirement of the selector.
+func (sel Selector) Matches(labels map[string]string) bool {
+	for _, req := range sel {
+		val, ok := labels[req.key]
+		switch req.op {
+		case "=":
+			if !ok || val != req.value {
+				return false
+			}
+		case "!=":
+			if ok && val == req.value {
+				return false
+			}
+		case "!":
+			if ok {
+				return false
+			}
+		default:
+			if !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (sel Selector) String() string {
+	parts := make([]string, 0, len(sel))
+	for _, req := range sel {
+		switch req.op {
+		case "exists":
+			parts = append(parts, req.key)
+		case "!":
+			parts = append(parts, "!"+req.key)
+		default:
+			parts = append(parts, req.key+req.op+req.value)
+		}
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,47 @@
+package recipe_test
+
+import (
+	"testing"
+
+	"github.com/raystack/meteor/recipe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelector(t *testing.T) {
+	labels := map[string]string{"team": "payments", "env": "prod"}
+
+	tests := []struct {
+		selector string
+		expected bool
+	}{
+		{selector: "", expected: true},
+		{selector: "team=payments", expected: true},
+		{selector: "team==payments", expected: true},
+		{selector: "team=search", expected: false},
+		{selector: "team=payments,env=prod", expected: true},
+		{selector: "team=payments,env=dev", expected: false},
+		{selector: "env!=dev", expected: true},
+		{selector: "env!=prod", expected: false},
+		{selector: "tier!=gold", expected: true},
+		{selector: "team", expected: true},
+		{selector: "tier", expected: false},
+		{selector: "!tier", expected: true},
+		{selector: "!team", expected: false},
+		{selector: " team = payments , !tier ", expected: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.selector, func(t *testing.T) {
+			sel, err := recipe.ParseSelector(tc.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, sel.Matches(labels))
+		})
+	}
+}
+
+func TestParseSelectorInvalid(t *testing.T) {
+	for _, s := range []string{"=payments", "!", "team name=payments"} {
+		_, err := recipe.ParseSelector(s)
+		assert.Error(t, err, s)
+	}
+}
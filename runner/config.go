@@ -1,8 +1,10 @@
 package runner
 
 import (
+	"io"
 	"time"
 
+	"github.com/raystack/meteor/models"
 	"github.com/raystack/meteor/registry"
 	log "github.com/raystack/salt/observability/logger"
 )
@@ -20,4 +22,11 @@ type Config struct {
 	SinkBatchSize        int
 	DryRun               bool
 	RecordLimit          int
+	// TraceURN is a URN glob; matching records have their journey
+	// through the extractor, processors and sinks written to TraceOutput.
+	TraceURN    string
+	TraceOutput io.Writer
+	// DryRunCollector, when set, receives the records that would have been
+	// sent to sinks in dry-run mode. It may be called concurrently.
+	DryRunCollector func(recipeName string, records []models.Record)
 }
@@ -8,9 +8,20 @@ type Run struct {
 	Error            error          `json:"error"`
 	DurationInMs     int            `json:"duration_in_ms"`
 	ExtractorRetries int            `json:"extractor_retries"`
-	RecordsExtracted  int            `json:"records_extracted"`
+	RecordsExtracted int            `json:"records_extracted"`
 	RecordCount      int            `json:"record_count"`
 	Success          bool           `json:"success"`
 	EntityTypes      map[string]int `json:"entity_types,omitempty"`
 	DryRun           bool           `json:"dry_run,omitempty"`
+	Sources          []SourceRun    `json:"sources,omitempty"`
+}
+
+// SourceRun contains the json data of a single source of a run.
+type SourceRun struct {
+	Name         string `json:"name"`
+	Scope        string `json:"scope"`
+	RecordCount  int    `json:"record_count"`
+	Retries      int    `json:"retries"`
+	DurationInMs int    `json:"duration_in_ms"`
+	Error        error  `json:"error"`
 }
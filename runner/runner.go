@@ -3,6 +3,7 @@ package runner
 import (
 	"context"
 	"fmt"
+	"io"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
@@ -34,6 +35,8 @@ type Runner struct {
 	sinkBatchSize    int
 	dryRun           bool
 	recordLimit      int
+	tracer           *tracer
+	dryRunCollector  func(recipeName string, records []models.Record)
 }
 
 // NewRunner returns a Runner with plugin factories.
@@ -58,21 +61,32 @@ func NewRunner(config Config) *Runner {
 		sinkBatchSize:    config.SinkBatchSize,
 		dryRun:           config.DryRun,
 		recordLimit:      config.RecordLimit,
+		tracer:           newTracer(config.TraceURN, config.TraceOutput),
+		dryRunCollector:  config.DryRunCollector,
 	}
 }
 
 // Validate checks the recipe for linting errors.
 func (r *Runner) Validate(rcp recipe.Recipe) []error {
 	var errs []error
-	if ext, err := r.extractorFactory.Get(rcp.Source.Name); err != nil {
-		errs = append(errs, err)
-	} else {
-		if err = ext.Validate(plugins.Config{
-			URNScope:  rcp.Source.Scope,
-			RawConfig: rcp.Source.Config,
+	sources := rcp.AllSources()
+	for i, src := range sources {
+		var srcErr error
+		if ext, err := r.extractorFactory.Get(src.Name); err != nil {
+			srcErr = err
+		} else if err = ext.Validate(plugins.Config{
+			URNScope:  src.Scope,
+			RawConfig: src.Config,
 		}); err != nil {
-			errs = append(errs, r.enrichInvalidConfigError(err, rcp.Source.Name, plugins.PluginTypeExtractor))
+			srcErr = r.enrichInvalidConfigError(err, src.Name, plugins.PluginTypeExtractor)
+		}
+		if srcErr == nil {
+			continue
+		}
+		if len(sources) > 1 {
+			srcErr = SourceError{Index: i, Err: srcErr}
 		}
+		errs = append(errs, srcErr)
 	}
 
 	for _, s := range rcp.Sinks {
@@ -131,13 +145,14 @@ func (r *Runner) Run(ctx context.Context, recipe recipe.Recipe) (run Run) {
 	}
 
 	var (
-		getDuration       = r.timerFn()
-		stream            = newStream()
-		recordCnt         int64
-		extractorRetryCnt int64
-		entityMu          sync.Mutex
-		limitCtx          = ctx
-		limitCancel       context.CancelFunc
+		getDuration = r.timerFn()
+		stream      = newStream()
+		recordCnt   int64
+		entityMu    sync.Mutex
+		limitCtx    = ctx
+		limitCancel context.CancelFunc
+		sources     = recipe.AllSources()
+		sourceRuns  = newSourceRuns(sources)
 	)
 
 	if r.recordLimit > 0 {
@@ -147,22 +162,34 @@ func (r *Runner) Run(ctx context.Context, recipe recipe.Recipe) (run Run) {
 
 	defer func() {
 		run.DurationInMs = getDuration()
-		run.ExtractorRetries = int(extractorRetryCnt)
+		run.Sources = sourceRuns.snapshot()
+		for _, sr := range run.Sources {
+			run.ExtractorRetries += sr.Retries
+		}
 		run.RecordsExtracted = int(recordCnt)
 		r.logAndRecordMetrics(ctx, run)
 	}()
 
-	runExtractor, err := r.setupExtractor(limitCtx, recipe.Source, stream)
-	if err != nil {
-		run.Error = fmt.Errorf("setup extractor %q: %w", recipe.Source.Name, err)
-		return run
+	runExtractors := make([]func() error, len(sources))
+	for i, src := range sources {
+		runExtractor, err := r.setupExtractor(limitCtx, src, sourceRuns.emit(i, stream.push), recipe.Name)
+		if err != nil {
+			run.Error = fmt.Errorf("setup extractor %q: %w", src.Name, err)
+			return run
+		}
+		runExtractors[i] = runExtractor
 	}
 
+	var committers []namedCommitter
 	for _, pr := range recipe.Processors {
-		if err := r.setupProcessor(ctx, pr, stream, recipe.Name); err != nil {
+		committer, err := r.setupProcessor(ctx, pr, stream, recipe.Name)
+		if err != nil {
 			run.Error = fmt.Errorf("setup processor %q: %w", pr.Name, err)
 			return run
 		}
+		if committer != nil {
+			committers = append(committers, namedCommitter{name: pr.Name, Committer: committer})
+		}
 	}
 
 	if !r.dryRun {
@@ -176,6 +203,10 @@ func (r *Runner) Run(ctx context.Context, recipe recipe.Recipe) (run Run) {
 	} else {
 		// In dry-run mode, add a no-op subscriber so the stream pipeline works.
 		stream.subscribe(func(records []models.Record) error {
+			r.tracer.skipped(recipe.Name, records)
+			if r.dryRunCollector != nil {
+				r.dryRunCollector(recipe.Name, records)
+			}
 			return nil
 		}, 1)
 	}
@@ -208,35 +239,31 @@ func (r *Runner) Run(ctx context.Context, recipe recipe.Recipe) (run Run) {
 		stream.Close()
 	}()
 
-	// a goroutine to let extractor concurrently emit data
+	// a goroutine to let extractors concurrently emit data
 	// while stream is listening via stream.Listen().
 	go func() {
-		defer func() {
-			if rcvr := recover(); rcvr != nil {
-				r.logger.Error("panic recovered")
-				r.logger.Info(string(debug.Stack()))
-				run.Error = fmt.Errorf("agent run: close stream: panic: %s", rcvr)
-			}
-			stream.Shutdown()
-		}()
-
-		retryNotification := func(e error, d time.Duration) {
-			atomic.AddInt64(&extractorRetryCnt, 1)
-			r.logger.Warn(
-				fmt.Sprintf("retrying extractor in %s", d),
-				"retry_delay_ms", d.Milliseconds(),
-				"extractor", recipe.Source.Name,
-				"error", e,
-			)
+		defer stream.Shutdown()
+
+		var wg sync.WaitGroup
+		for i := range sources {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				r.runSource(limitCtx, sources[i], runExtractors[i], sourceRuns, i)
+			}(i)
 		}
-
-		err := r.retrier.retry(
-			limitCtx,
-			func() error { return runExtractor() },
-			retryNotification,
-		)
-		if err != nil && limitCtx.Err() == nil {
-			run.Error = fmt.Errorf("run extractor: %w", err)
+		wg.Wait()
+	}()
+	// processors keeping state commit it once the sinks are closed, and only
+	// when the records reached them.
+	defer func() {
+		if !run.Success || r.dryRun {
+			return
+		}
+		for _, c := range committers {
+			if err := c.Commit(); err != nil {
+				r.logger.Warn("error committing processor", "processor", c.name, "error", err)
+			}
 		}
 	}()
 	defer stream.Close()
@@ -245,6 +272,8 @@ func (r *Runner) Run(ctx context.Context, recipe recipe.Recipe) (run Run) {
 	// this process is blocking
 	if err := stream.broadcast(); err != nil {
 		run.Error = fmt.Errorf("broadcast stream: %w", err)
+	} else {
+		run.Error = sourceRuns.err()
 	}
 
 	// code will reach here stream.Listen() is done.
@@ -253,7 +282,41 @@ func (r *Runner) Run(ctx context.Context, recipe recipe.Recipe) (run Run) {
 	return run
 }
 
-func (r *Runner) setupExtractor(ctx context.Context, sr recipe.PluginRecipe, str *stream) (runFn func() error, err error) {
+// runSource runs the extractor of a source, retrying it on its own so that
+// a failing source does not hold back the others.
+func (r *Runner) runSource(ctx context.Context, src recipe.PluginRecipe, runExtractor func() error, runs *sourceRuns, i int) {
+	getDuration := r.timerFn()
+	defer func() {
+		if rcvr := recover(); rcvr != nil {
+			r.logger.Error("panic recovered")
+			r.logger.Info(string(debug.Stack()))
+			runs.fail(i, fmt.Errorf("agent run: close stream: panic: %s", rcvr))
+		}
+		runs.finish(i, getDuration())
+	}()
+
+	retryNotification := func(e error, d time.Duration) {
+		runs.retried(i)
+		r.logger.Warn(
+			fmt.Sprintf("retrying extractor in %s", d),
+			"retry_delay_ms", d.Milliseconds(),
+			"extractor", src.Name,
+			"scope", src.Scope,
+			"error", e,
+		)
+	}
+
+	err := r.retrier.retry(
+		ctx,
+		func() error { return runExtractor() },
+		retryNotification,
+	)
+	if err != nil && ctx.Err() == nil {
+		runs.fail(i, fmt.Errorf("run extractor: %w", err))
+	}
+}
+
+func (r *Runner) setupExtractor(ctx context.Context, sr recipe.PluginRecipe, push plugins.Emit, recipeName string) (runFn func() error, err error) {
 	extractor, err := r.extractorFactory.Get(sr.Name)
 	if err != nil {
 		return nil, fmt.Errorf("find extractor %q: %w", sr.Name, err)
@@ -262,31 +325,71 @@ func (r *Runner) setupExtractor(ctx context.Context, sr recipe.PluginRecipe, str
 		return nil, fmt.Errorf("initiate extractor %q: %w", sr.Name, err)
 	}
 
+	emit := push
+	if r.tracer != nil {
+		emit = func(rec models.Record) {
+			r.tracer.extracted(recipeName, sr.Name, rec)
+			push(rec)
+		}
+	}
+
 	return func() error {
-		if err := extractor.Extract(ctx, str.push); err != nil {
+		if err := extractor.Extract(ctx, emit); err != nil {
 			return fmt.Errorf("run extractor %q: %w", sr.Name, err)
 		}
 		return nil
 	}, nil
 }
 
-func (r *Runner) setupProcessor(ctx context.Context, pr recipe.PluginRecipe, str *stream, recipeName string) (err error) {
+// namedCommitter is a processor committing its state after a successful run.
+type namedCommitter struct {
+	name string
+	plugins.Committer
+}
+
+func (r *Runner) setupProcessor(ctx context.Context, pr recipe.PluginRecipe, str *stream, recipeName string) (committer plugins.Committer, err error) {
 	proc, err := r.processorFactory.Get(pr.Name)
 	if err != nil {
-		return fmt.Errorf("find processor %q: %w", pr.Name, err)
+		return nil, fmt.Errorf("find processor %q: %w", pr.Name, err)
+	}
+	committer, _ = proc.(plugins.Committer)
+	if emitter, ok := proc.(plugins.RecordEmitter); ok {
+		emitter.SetEmit(str.emit)
+	}
+	if closer, ok := proc.(io.Closer); ok {
+		str.onClose(func() {
+			if err := closer.Close(); err != nil {
+				r.logger.Warn("error closing processor", "processor", pr.Name, "error", err)
+			}
+		})
 	}
 
 	proc = otelmw.WithProcessor(pr.Name, recipeName)(proc)
 	if err != nil {
-		return fmt.Errorf("wrap processor %q: %w", pr.Name, err)
+		return nil, fmt.Errorf("wrap processor %q: %w", pr.Name, err)
 	}
 
 	if err := proc.Init(ctx, recipeToPluginConfig(pr)); err != nil {
-		return fmt.Errorf("initiate processor %q: %w", pr.Name, err)
+		return nil, fmt.Errorf("initiate processor %q: %w", pr.Name, err)
 	}
 
+	match := newRecordSelector(pr.Match)
 	str.setMiddleware(func(src models.Record) (models.Record, error) {
+		if !match.matches(src) {
+			r.tracer.unmatched(recipeName, pr.Name, src)
+			return src, nil
+		}
+
+		var before models.Record
+		traced := r.tracer.matches(src)
+		if traced {
+			before = cloneRecord(src)
+		}
+
 		dst, err := proc.Process(ctx, src)
+		if traced {
+			r.tracer.processed(recipeName, pr.Name, before, dst, err)
+		}
 		if err != nil {
 			return models.Record{}, fmt.Errorf("run processor %q: %w", pr.Name, err)
 		}
@@ -294,7 +397,7 @@ func (r *Runner) setupProcessor(ctx context.Context, pr recipe.PluginRecipe, str
 		return dst, nil
 	})
 
-	return nil
+	return committer, nil
 }
 
 func (r *Runner) setupSink(ctx context.Context, sr recipe.PluginRecipe, stream *stream, recipeName string) error {
@@ -330,7 +433,17 @@ func (r *Runner) setupSink(ctx context.Context, sr recipe.PluginRecipe, stream *
 			"error", e.Error(),
 		)
 	}
-	stream.subscribe(func(records []models.Record) error {
+	var match func(models.Record) bool
+	if when := newRecordSelector(sr.When); when != nil {
+		match = func(rec models.Record) bool {
+			if when.matches(rec) {
+				return true
+			}
+			r.tracer.unrouted(recipeName, sr.Name, rec)
+			return false
+		}
+	}
+	stream.subscribeMatching(match, func(records []models.Record) error {
 		pluginInfo.BatchSize = len(records)
 
 		err := r.retrier.retry(
@@ -342,6 +455,7 @@ func (r *Runner) setupSink(ctx context.Context, sr recipe.PluginRecipe, stream *
 		)
 
 		pluginInfo.Success = err == nil
+		r.tracer.sunk(recipeName, sr.Name, records, err)
 		if err != nil {
 			// once it reaches here, it means that the retry has been exhausted and still got error
 			r.logger.Error("error running sink", "sink", sr.Name, "error", err.Error())
@@ -1,8 +1,10 @@
 package runner_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -997,6 +999,445 @@ func TestRunnerRun(t *testing.T) {
 		})
 		assert.NoError(t, run.Error)
 	})
+
+	t.Run("should trace journey of records matching trace urn", func(t *testing.T) {
+		traced := models.NewRecord(models.NewEntity("urn:test:scope:table:orders", "table", "orders", "test", nil))
+		other := models.NewRecord(models.NewEntity("urn:test:scope:table:users", "table", "users", "test", nil))
+		data := []models.Record{traced, other}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit(data)
+		extr.On("Init", mockCtx, buildPluginConfig(validRecipe.Source)).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil)
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+
+		proc := &describeProcessor{}
+		proc.On("Init", mockCtx, buildPluginConfig(validRecipe.Processors[0])).Return(nil).Once()
+		pf := registry.NewProcessorFactory()
+		if err := pf.Register("test-processor", newProcessor(proc)); err != nil {
+			t.Fatal(err)
+		}
+
+		sink := mocks.NewSink()
+		sink.On("Init", mockCtx, buildPluginConfig(validRecipe.Sinks[0])).Return(nil).Once()
+		sink.On("Sink", mockCtx, mock.Anything).Return(errors.New("rejected by test"))
+		sink.On("Close").Return(nil)
+		sf := registry.NewSinkFactory()
+		if err := sf.Register("test-sink", newSink(sink)); err != nil {
+			t.Fatal(err)
+		}
+
+		var out bytes.Buffer
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: pf,
+			SinkFactory:      sf,
+			Logger:           utils.Logger,
+			TraceURN:         "urn:test:*:orders",
+			TraceOutput:      &out,
+		})
+		run := r.Run(ctx, validRecipe)
+		assert.NoError(t, run.Error)
+
+		trace := out.String()
+		assert.Contains(t, trace, `extractor "test-extractor" emitted record`)
+		assert.Contains(t, trace, `processor "test-processor" changed record`)
+		assert.Contains(t, trace, `+ entity.description: "processed"`)
+		assert.Contains(t, trace, `sink "test-sink" rejected record: rejected by test`)
+		assert.NotContains(t, trace, "urn:test:scope:table:users")
+	})
+
+	t.Run("should only send records selected by when to a sink", func(t *testing.T) {
+		orders := models.NewRecord(models.NewEntity("urn:test:scope:table:orders", "table", "orders", "test", map[string]any{
+			"labels": map[string]any{"team": "data"},
+		}))
+		payroll := models.NewRecord(models.NewEntity("urn:test:scope:table:payroll", "table", "payroll", "test", map[string]any{
+			"labels": map[string]any{"team": "hr"},
+		}))
+		alice := models.NewRecord(models.NewEntity("urn:test:scope:user:alice", "user", "alice", "test", nil))
+		rcp := recipe.Recipe{
+			Name:   "sample",
+			Source: recipe.PluginRecipe{Name: "test-extractor"},
+			Sinks: []recipe.PluginRecipe{
+				{Name: "test-sink", When: recipe.RecordSelector{Types: []string{"user"}}},
+				{Name: "other-sink", When: recipe.RecordSelector{
+					URNs:       []string{"urn:test:*:table:*"},
+					Properties: map[string]string{"labels.team": "da*"},
+				}},
+				{Name: "all-sink"},
+			},
+		}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit([]models.Record{orders, payroll, alice})
+		extr.On("Init", mockCtx, buildPluginConfig(rcp.Source)).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil).Once()
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+
+		sf := registry.NewSinkFactory()
+		for name, records := range map[string][]models.Record{
+			"test-sink":  {alice},
+			"other-sink": {orders},
+			"all-sink":   {orders, payroll, alice},
+		} {
+			sink := mocks.NewSink()
+			sink.On("Init", mockCtx, mock.Anything).Return(nil).Once()
+			sink.On("Sink", mockCtx, records).Return(nil).Once()
+			sink.On("Close").Return(nil)
+			defer sink.AssertExpectations(t)
+			if err := sf.Register(name, newSink(sink)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var out bytes.Buffer
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: registry.NewProcessorFactory(),
+			SinkFactory:      sf,
+			Logger:           utils.Logger,
+			TraceURN:         "urn:test:scope:user:alice",
+			TraceOutput:      &out,
+		})
+		run := r.Run(ctx, rcp)
+		assert.NoError(t, run.Error)
+		assert.Equal(t, 3, run.RecordCount)
+		assert.Contains(t, out.String(), `record not selected by when of sink "other-sink"`)
+	})
+
+	t.Run("should pass records not selected by match through a processor", func(t *testing.T) {
+		orders := models.NewRecord(models.NewEntity("urn:bigquery:scope:table:orders", "table", "orders", "bigquery", nil))
+		dashboard := models.NewRecord(models.NewEntity("urn:metabase:scope:dashboard:sales", "dashboard", "sales", "metabase", nil))
+		rcp := recipe.Recipe{
+			Name:   "sample",
+			Source: recipe.PluginRecipe{Name: "test-extractor"},
+			Processors: []recipe.PluginRecipe{
+				{Name: "test-processor", Match: recipe.RecordSelector{Types: []string{"table"}, Sources: []string{"bigquery"}}},
+			},
+		}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit([]models.Record{orders, dashboard})
+		extr.On("Init", mockCtx, buildPluginConfig(rcp.Source)).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil).Once()
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+
+		proc := &describeProcessor{}
+		proc.On("Init", mockCtx, buildPluginConfig(rcp.Processors[0])).Return(nil).Once()
+		pf := registry.NewProcessorFactory()
+		if err := pf.Register("test-processor", newProcessor(proc)); err != nil {
+			t.Fatal(err)
+		}
+
+		var collected []models.Record
+		var out bytes.Buffer
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: pf,
+			SinkFactory:      registry.NewSinkFactory(),
+			Logger:           utils.Logger,
+			DryRun:           true,
+			TraceURN:         "urn:metabase:*",
+			TraceOutput:      &out,
+			DryRunCollector: func(_ string, records []models.Record) {
+				collected = append(collected, records...)
+			},
+		})
+		run := r.Run(ctx, rcp)
+		assert.NoError(t, run.Error)
+		if assert.Len(t, collected, 2) {
+			assert.Equal(t, "processed", collected[0].Entity().GetDescription())
+			assert.Empty(t, collected[1].Entity().GetDescription())
+		}
+		assert.Contains(t, out.String(), `processor "test-processor" skipped record not selected by match`)
+	})
+
+	t.Run("should send records emitted by processors to sinks and close processors", func(t *testing.T) {
+		orders := models.NewRecord(models.NewEntity("urn:test:scope:table:orders", "table", "orders", "test", nil))
+		rcp := recipe.Recipe{
+			Name:   "sample",
+			Source: recipe.PluginRecipe{Name: "test-extractor"},
+			Processors: []recipe.PluginRecipe{
+				{Name: "emit-processor"},
+				{Name: "describe-processor"},
+			},
+		}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit([]models.Record{orders})
+		extr.On("Init", mockCtx, buildPluginConfig(rcp.Source)).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil).Once()
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+
+		emitter := &emitProcessor{}
+		emitter.On("Init", mockCtx, buildPluginConfig(rcp.Processors[0])).Return(nil).Once()
+		describer := &describeProcessor{}
+		describer.On("Init", mockCtx, buildPluginConfig(rcp.Processors[1])).Return(nil).Once()
+		pf := registry.NewProcessorFactory()
+		if err := pf.Register("emit-processor", newProcessor(emitter)); err != nil {
+			t.Fatal(err)
+		}
+		if err := pf.Register("describe-processor", newProcessor(describer)); err != nil {
+			t.Fatal(err)
+		}
+
+		var collected []models.Record
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: pf,
+			SinkFactory:      registry.NewSinkFactory(),
+			Logger:           utils.Logger,
+			DryRun:           true,
+			DryRunCollector: func(_ string, records []models.Record) {
+				collected = append(collected, records...)
+			},
+		})
+		run := r.Run(ctx, rcp)
+		assert.NoError(t, run.Error)
+		assert.Equal(t, 1, run.RecordCount)
+		if assert.Len(t, collected, 2) {
+			assert.Equal(t, "urn:test:scope:event:orders", collected[0].Entity().GetUrn())
+			assert.Empty(t, collected[0].Entity().GetDescription(), "emitted records skip the processors")
+			assert.Equal(t, "processed", collected[1].Entity().GetDescription())
+		}
+		assert.True(t, emitter.closed)
+	})
+
+	t.Run("should commit processor state only after a successful run that is not a dry run", func(t *testing.T) {
+		state := filepath.Join(t.TempDir(), "schema_diff.json")
+		rcp := recipe.Recipe{
+			Name:       "sample",
+			Source:     recipe.PluginRecipe{Name: "test-extractor"},
+			Processors: []recipe.PluginRecipe{{Name: "schema_diff", Config: map[string]any{"state": state}}},
+			Sinks:      []recipe.PluginRecipe{{Name: "test-sink"}},
+		}
+		orders := models.NewRecord(models.NewEntity("urn:test:scope:table:orders", "table", "orders", "test", map[string]any{
+			"columns": []any{map[string]any{"name": "id", "data_type": "INT64"}},
+		}))
+		run := func(dryRun bool, sinkErr error) runner.Run {
+			extr := mocks.NewExtractor()
+			extr.SetEmit([]models.Record{orders})
+			extr.On("Init", mockCtx, buildPluginConfig(rcp.Source)).Return(nil).Once()
+			extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil).Once()
+			ef := registry.NewExtractorFactory()
+			if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+				t.Fatal(err)
+			}
+
+			sink := mocks.NewSink()
+			sink.On("Init", mockCtx, buildPluginConfig(rcp.Sinks[0])).Return(nil).Maybe()
+			sink.On("Sink", mockCtx, mock.Anything).Return(sinkErr).Maybe()
+			sink.On("Close").Return(nil).Maybe()
+			sf := registry.NewSinkFactory()
+			if err := sf.Register("test-sink", newSink(sink)); err != nil {
+				t.Fatal(err)
+			}
+
+			return runner.NewRunner(runner.Config{
+				ExtractorFactory: ef,
+				ProcessorFactory: registry.Processors,
+				SinkFactory:      sf,
+				Logger:           utils.Logger,
+				StopOnSinkError:  true,
+				DryRun:           dryRun,
+			}).Run(ctx, rcp)
+		}
+
+		assert.NoError(t, run(true, nil).Error)
+		assert.NoFileExists(t, state, "dry runs leave the state untouched")
+
+		assert.Error(t, run(false, errors.New("some error")).Error)
+		assert.NoFileExists(t, state, "failed runs leave the state untouched")
+
+		assert.NoError(t, run(false, nil).Error)
+		assert.FileExists(t, state)
+	})
+
+	t.Run("should trace records skipped by dry-run", func(t *testing.T) {
+		data := []models.Record{
+			models.NewRecord(models.NewEntity("urn:test:scope:table:orders", "table", "orders", "test", nil)),
+		}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit(data)
+		extr.On("Init", mockCtx, buildPluginConfig(validRecipe.Source)).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil)
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+
+		proc := mocks.NewProcessor()
+		proc.On("Init", mockCtx, buildPluginConfig(validRecipe.Processors[0])).Return(nil).Once()
+		proc.On("Process", mockCtx, data[0]).Return(data[0], nil)
+		pf := registry.NewProcessorFactory()
+		if err := pf.Register("test-processor", newProcessor(proc)); err != nil {
+			t.Fatal(err)
+		}
+
+		var out bytes.Buffer
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: pf,
+			SinkFactory:      registry.NewSinkFactory(),
+			Logger:           utils.Logger,
+			DryRun:           true,
+			TraceURN:         "*orders",
+			TraceOutput:      &out,
+		})
+		run := r.Run(ctx, validRecipe)
+		assert.NoError(t, run.Error)
+		assert.Contains(t, out.String(), `processor "test-processor" made no changes`)
+		assert.Contains(t, out.String(), "dry-run: record not sent to sinks")
+	})
+
+	t.Run("should pass records to dry-run collector", func(t *testing.T) {
+		data := []models.Record{
+			models.NewRecord(models.NewEntity("urn:test:scope:table:orders", "table", "orders", "test", nil)),
+		}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit(data)
+		extr.On("Init", mockCtx, buildPluginConfig(validRecipe.Source)).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil)
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+
+		proc := mocks.NewProcessor()
+		proc.On("Init", mockCtx, buildPluginConfig(validRecipe.Processors[0])).Return(nil).Once()
+		proc.On("Process", mockCtx, data[0]).Return(data[0], nil)
+		pf := registry.NewProcessorFactory()
+		if err := pf.Register("test-processor", newProcessor(proc)); err != nil {
+			t.Fatal(err)
+		}
+
+		var collected []models.Record
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: pf,
+			SinkFactory:      registry.NewSinkFactory(),
+			Logger:           utils.Logger,
+			DryRun:           true,
+			DryRunCollector: func(recipeName string, records []models.Record) {
+				assert.Equal(t, validRecipe.Name, recipeName)
+				collected = append(collected, records...)
+			},
+		})
+		run := r.Run(ctx, validRecipe)
+		assert.NoError(t, run.Error)
+		assert.Equal(t, data, collected)
+	})
+
+	t.Run("should run all sources of a recipe into the same stream", func(t *testing.T) {
+		orders := models.NewRecord(models.NewEntity("urn:test:shop:table:orders", "table", "orders", "test", nil))
+		topic := models.NewRecord(models.NewEntity("urn:kafka:events:topic:clicks", "topic", "clicks", "kafka", nil))
+		rcp := recipe.Recipe{
+			Name: "sample",
+			Sources: []recipe.PluginRecipe{
+				{Name: "test-extractor", Scope: "shop"},
+				{Name: "other-extractor", Scope: "events"},
+			},
+		}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit([]models.Record{orders})
+		extr.On("Init", mockCtx, buildPluginConfig(rcp.Sources[0])).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil).Once()
+		other := mocks.NewExtractor()
+		other.SetEmit([]models.Record{topic})
+		other.On("Init", mockCtx, buildPluginConfig(rcp.Sources[1])).Return(nil).Once()
+		other.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil).Once()
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+		if err := ef.Register("other-extractor", newExtractor(other)); err != nil {
+			t.Fatal(err)
+		}
+
+		var collected []models.Record
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: registry.NewProcessorFactory(),
+			SinkFactory:      registry.NewSinkFactory(),
+			Logger:           utils.Logger,
+			DryRun:           true,
+			TimerFn:          func() func() int { return func() int { return 0 } },
+			DryRunCollector: func(_ string, records []models.Record) {
+				collected = append(collected, records...)
+			},
+		})
+		run := r.Run(ctx, rcp)
+		assert.NoError(t, run.Error)
+		assert.ElementsMatch(t, []models.Record{orders, topic}, collected)
+		assert.Equal(t, 2, run.RecordCount)
+		assert.Equal(t, map[string]int{"table": 1, "topic": 1}, run.EntityTypes)
+		assert.Equal(t, []runner.SourceRun{
+			{Name: "test-extractor", Scope: "shop", RecordCount: 1},
+			{Name: "other-extractor", Scope: "events", RecordCount: 1},
+		}, run.Sources)
+	})
+
+	t.Run("should keep records of other sources when one source fails", func(t *testing.T) {
+		orders := models.NewRecord(models.NewEntity("urn:test:shop:table:orders", "table", "orders", "test", nil))
+		rcp := recipe.Recipe{
+			Name: "sample",
+			Sources: []recipe.PluginRecipe{
+				{Name: "test-extractor", Scope: "shop"},
+				{Name: "other-extractor", Scope: "events"},
+			},
+		}
+
+		extr := mocks.NewExtractor()
+		extr.SetEmit([]models.Record{orders})
+		extr.On("Init", mockCtx, buildPluginConfig(rcp.Sources[0])).Return(nil).Once()
+		extr.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(nil).Once()
+		other := mocks.NewExtractor()
+		other.On("Init", mockCtx, buildPluginConfig(rcp.Sources[1])).Return(nil).Once()
+		other.On("Extract", mockCtx, mock.AnythingOfType("plugins.Emit")).Return(errors.New("some error")).Once()
+		ef := registry.NewExtractorFactory()
+		if err := ef.Register("test-extractor", newExtractor(extr)); err != nil {
+			t.Fatal(err)
+		}
+		if err := ef.Register("other-extractor", newExtractor(other)); err != nil {
+			t.Fatal(err)
+		}
+
+		var collected []models.Record
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: ef,
+			ProcessorFactory: registry.NewProcessorFactory(),
+			SinkFactory:      registry.NewSinkFactory(),
+			Logger:           utils.Logger,
+			DryRun:           true,
+			DryRunCollector: func(_ string, records []models.Record) {
+				collected = append(collected, records...)
+			},
+		})
+		run := r.Run(ctx, rcp)
+		assert.False(t, run.Success)
+		assert.ErrorContains(t, run.Error, "some error")
+		var srcErr runner.SourceError
+		assert.ErrorAs(t, run.Error, &srcErr)
+		assert.Equal(t, 1, srcErr.Index)
+		assert.Equal(t, []models.Record{orders}, collected)
+		assert.NoError(t, run.Sources[0].Error)
+		assert.Error(t, run.Sources[1].Error)
+	})
 }
 
 func TestRunnerRunMultiple(t *testing.T) {
@@ -1052,10 +1493,14 @@ func TestRunnerRunMultiple(t *testing.T) {
 		assert.Len(t, runs, len(recipeList))
 		for i := range runs {
 			runs[i].DurationInMs = 0
+			for j := range runs[i].Sources {
+				runs[i].Sources[j].DurationInMs = 0
+			}
 		}
+		sources := []runner.SourceRun{{Name: "test-extractor", RecordCount: len(data)}}
 		assert.Equal(t, []runner.Run{
-			{Recipe: validRecipe, RecordCount: len(data), RecordsExtracted: 1, Success: true, EntityTypes: map[string]int{}},
-			{Recipe: validRecipe2, RecordCount: len(data), RecordsExtracted: 1, Success: true, EntityTypes: map[string]int{}},
+			{Recipe: validRecipe, RecordCount: len(data), RecordsExtracted: 1, Success: true, EntityTypes: map[string]int{}, Sources: sources},
+			{Recipe: validRecipe2, RecordCount: len(data), RecordsExtracted: 1, Success: true, EntityTypes: map[string]int{}, Sources: sources},
 		}, runs)
 	})
 }
@@ -1141,6 +1586,28 @@ func TestValidate(t *testing.T) {
 		expectedErrs = append(expectedErrs, enrichInvalidConfigError(err, invalidRecipe.Processors[0].Name, plugins.PluginTypeProcessor))
 		assert.Equal(t, expectedErrs, errs)
 	})
+	t.Run("should return the index of the source for recipes with multiple sources", func(t *testing.T) {
+		r := runner.NewRunner(runner.Config{
+			ExtractorFactory: registry.NewExtractorFactory(),
+			ProcessorFactory: registry.NewProcessorFactory(),
+			SinkFactory:      registry.NewSinkFactory(),
+			Logger:           utils.Logger,
+		})
+		errs := r.Validate(recipe.Recipe{
+			Name: "sample",
+			Sources: []recipe.PluginRecipe{
+				{Name: "test-extractor"},
+				{Name: "other-extractor"},
+			},
+		})
+		assert.Equal(t, 2, len(errs))
+		for i, name := range []string{"test-extractor", "other-extractor"} {
+			var srcErr runner.SourceError
+			assert.ErrorAs(t, errs[i], &srcErr)
+			assert.Equal(t, i, srcErr.Index)
+			assert.ErrorIs(t, errs[i], plugins.NotFoundError{Type: plugins.PluginTypeExtractor, Name: name})
+		}
+	})
 }
 
 func newExtractor(extr plugins.Extractor) func() plugins.Extractor {
@@ -1197,6 +1664,37 @@ func (p *panicProcessor) Process(_ context.Context, _ models.Record) (dst models
 	panic("panicking")
 }
 
+// describeProcessor sets the entity description in place, like most processors do.
+type describeProcessor struct {
+	mocks.Processor
+}
+
+func (p *describeProcessor) Process(_ context.Context, src models.Record) (dst models.Record, err error) {
+	src.Entity().Description = "processed"
+	return src, nil
+}
+
+// emitProcessor emits an event for each record it processes.
+type emitProcessor struct {
+	mocks.Processor
+	emit   plugins.Emit
+	closed bool
+}
+
+func (p *emitProcessor) SetEmit(emit plugins.Emit) {
+	p.emit = emit
+}
+
+func (p *emitProcessor) Process(_ context.Context, src models.Record) (dst models.Record, err error) {
+	p.emit(models.NewRecord(models.NewEntity("urn:test:scope:event:"+src.Entity().GetName(), "event", "event", "test", nil)))
+	return src, nil
+}
+
+func (p *emitProcessor) Close() error {
+	p.closed = true
+	return nil
+}
+
 // enrichInvalidConfigError enrich the error with plugin information
 func enrichInvalidConfigError(err error, pluginName string, pluginType plugins.PluginType) error {
 	var icErr plugins.InvalidConfigError
@@ -0,0 +1,105 @@
+package runner
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/raystack/meteor/internal/urnglob"
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/recipe"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// recordSelector is a compiled recipe.RecordSelector. A nil selector
+// matches every record.
+type recordSelector struct {
+	types      map[string]bool
+	urns       []*regexp.Regexp
+	sources    map[string]bool
+	properties map[string]*regexp.Regexp
+}
+
+func newRecordSelector(s recipe.RecordSelector) *recordSelector {
+	if s.IsZero() {
+		return nil
+	}
+
+	sel := &recordSelector{properties: make(map[string]*regexp.Regexp, len(s.Properties))}
+	sel.types = toSet(s.Types)
+	sel.sources = toSet(s.Sources)
+	for _, p := range s.URNs {
+		sel.urns = append(sel.urns, urnglob.Compile(p))
+	}
+	for path, p := range s.Properties {
+		sel.properties[path] = urnglob.Compile(p)
+	}
+	return sel
+}
+
+func (s *recordSelector) matches(rec models.Record) bool {
+	if s == nil {
+		return true
+	}
+
+	entity := rec.Entity()
+	if s.types != nil && !s.types[entity.GetType()] {
+		return false
+	}
+	if len(s.urns) > 0 && !matchAnyGlob(s.urns, entity.GetUrn()) {
+		return false
+	}
+	if s.sources != nil && !s.sources[entity.GetSource()] {
+		return false
+	}
+	for path, re := range s.properties {
+		v, ok := propertyValue(entity.GetProperties(), path)
+		if !ok || !re.MatchString(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// toSet returns nil for an empty list, which matches every value.
+func toSet(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+func matchAnyGlob(globs []*regexp.Regexp, s string) bool {
+	for _, g := range globs {
+		if g.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// propertyValue returns the scalar at a dotted path into the properties
+// as a string. Lists, maps and missing keys are not found.
+func propertyValue(props *structpb.Struct, path string) (string, bool) {
+	v := structpb.NewStructValue(props)
+	for _, key := range strings.Split(path, ".") {
+		var ok bool
+		if v, ok = v.GetStructValue().GetFields()[key]; !ok {
+			return "", false
+		}
+	}
+
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return kind.StringValue, true
+	case *structpb.Value_NumberValue:
+		return strconv.FormatFloat(kind.NumberValue, 'f', -1, 64), true
+	case *structpb.Value_BoolValue:
+		return strconv.FormatBool(kind.BoolValue), true
+	}
+	return "", false
+}
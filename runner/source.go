@@ -0,0 +1,92 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/raystack/meteor/models"
+	"github.com/raystack/meteor/plugins"
+	"github.com/raystack/meteor/recipe"
+)
+
+// SourceError is an error of a source of a recipe with multiple sources.
+type SourceError struct {
+	// Index is the position of the source in the sources of the recipe.
+	Index int
+	Err   error
+}
+
+func (e SourceError) Error() string {
+	return fmt.Sprintf("source %d: %s", e.Index, e.Err)
+}
+
+func (e SourceError) Unwrap() error {
+	return e.Err
+}
+
+// sourceRuns tracks the sources of a run while their extractors run
+// concurrently.
+type sourceRuns struct {
+	mu   sync.Mutex
+	runs []SourceRun
+}
+
+func newSourceRuns(sources []recipe.PluginRecipe) *sourceRuns {
+	runs := make([]SourceRun, len(sources))
+	for i, src := range sources {
+		runs[i] = SourceRun{Name: src.Name, Scope: src.Scope}
+	}
+	return &sourceRuns{runs: runs}
+}
+
+// emit wraps push to count the records of source i.
+func (s *sourceRuns) emit(i int, push plugins.Emit) plugins.Emit {
+	return func(rec models.Record) {
+		s.mu.Lock()
+		s.runs[i].RecordCount++
+		s.mu.Unlock()
+		push(rec)
+	}
+}
+
+func (s *sourceRuns) retried(i int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[i].Retries++
+}
+
+func (s *sourceRuns) fail(i int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[i].Error = err
+}
+
+func (s *sourceRuns) finish(i, durationInMs int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[i].DurationInMs = durationInMs
+}
+
+func (s *sourceRuns) snapshot() []SourceRun {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SourceRun(nil), s.runs...)
+}
+
+// err joins the errors of the sources. A run with a single source fails
+// with the error of its extractor as is.
+func (s *sourceRuns) err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.runs) == 1 {
+		return s.runs[0].Error
+	}
+	var errs []error
+	for i, sr := range s.runs {
+		if sr.Error != nil {
+			errs = append(errs, SourceError{Index: i, Err: fmt.Errorf("%s: %w", sr.Name, sr.Error)})
+		}
+	}
+	return errors.Join(errs...)
+}
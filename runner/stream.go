@@ -11,6 +11,7 @@ type (
 	streamMiddleware func(src models.Record) (dst models.Record, err error)
 	subscriber       struct {
 		callback  func([]models.Record) error
+		match     func(models.Record) bool
 		channel   chan models.Record
 		batchSize int
 	}
@@ -33,8 +34,15 @@ func newStream() *stream {
 // subscribe() will register callback with a batch size to the emitter.
 // Calling this will not start listening yet, use broadcast() to start sending data to subscriber.
 func (s *stream) subscribe(callback func(batch []models.Record) error, batchSize int) *stream {
+	return s.subscribeMatching(nil, callback, batchSize)
+}
+
+// subscribeMatching() is like subscribe() but only sends the records for which match returns true.
+// A nil match sends every record.
+func (s *stream) subscribeMatching(match func(models.Record) bool, callback func(batch []models.Record) error, batchSize int) *stream {
 	s.subscribers = append(s.subscribers, &subscriber{
 		callback:  callback,
+		match:     match,
 		batchSize: batchSize,
 		channel:   make(chan models.Record),
 	})
@@ -99,7 +107,16 @@ func (s *stream) push(data models.Record) {
 		return
 	}
 
+	s.emit(data)
+}
+
+// emit() sends the record to all registered subscribers without running the
+// middlewares, for records emitted by the middlewares themselves.
+func (s *stream) emit(data models.Record) {
 	for _, l := range s.subscribers {
+		if l.match != nil && !l.match(data) {
+			continue
+		}
 		l.channel <- data
 	}
 }
@@ -0,0 +1,142 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/raystack/meteor/internal/urnglob"
+	"github.com/raystack/meteor/models"
+	meteorv1beta1 "github.com/raystack/meteor/models/raystack/meteor/v1beta1"
+	"google.golang.org/protobuf/proto"
+)
+
+// tracer prints the journey of records whose URN matches a glob pattern:
+// the record as emitted by the extractor, the changes made by each
+// processor and the outcome of every sink.
+type tracer struct {
+	pattern *regexp.Regexp
+	out     io.Writer
+	mu      sync.Mutex
+}
+
+func newTracer(pattern string, out io.Writer) *tracer {
+	if pattern == "" {
+		return nil
+	}
+	if out == nil {
+		out = os.Stderr
+	}
+
+	return &tracer{pattern: urnglob.Compile(pattern), out: out}
+}
+
+// matches reports whether the record should be traced. It is safe to call on a nil tracer.
+func (t *tracer) matches(rec models.Record) bool {
+	if t == nil || rec.Entity() == nil {
+		return false
+	}
+	return t.pattern.MatchString(rec.Entity().GetUrn())
+}
+
+func (t *tracer) extracted(recipeName, extractor string, rec models.Record) {
+	if !t.matches(rec) {
+		return
+	}
+
+	b, err := models.RecordToJSON(rec)
+	if err != nil {
+		t.printf(recipeName, rec, "extractor %q emitted record (marshal error: %s)", extractor, err)
+		return
+	}
+	t.printf(recipeName, rec, "extractor %q emitted record\n    %s", extractor, b)
+}
+
+func (t *tracer) processed(recipeName, processor string, src, dst models.Record, procErr error) {
+	if procErr != nil {
+		t.printf(recipeName, src, "processor %q rejected record: %s", processor, procErr)
+		return
+	}
+
+	changes, err := models.DiffRecords(src, dst)
+	if err != nil {
+		t.printf(recipeName, src, "processor %q: diff error: %s", processor, err)
+		return
+	}
+	if len(changes) == 0 {
+		t.printf(recipeName, dst, "processor %q made no changes", processor)
+		return
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, c := range changes {
+		lines = append(lines, "    "+c.String())
+	}
+	t.printf(recipeName, dst, "processor %q changed record\n%s", processor, strings.Join(lines, "\n"))
+}
+
+func (t *tracer) sunk(recipeName, sink string, batch []models.Record, sinkErr error) {
+	if t == nil {
+		return
+	}
+
+	for _, rec := range batch {
+		if !t.matches(rec) {
+			continue
+		}
+		if sinkErr != nil {
+			t.printf(recipeName, rec, "sink %q rejected record: %s", sink, sinkErr)
+			continue
+		}
+		t.printf(recipeName, rec, "sink %q accepted record", sink)
+	}
+}
+
+func (t *tracer) unmatched(recipeName, processor string, rec models.Record) {
+	if t.matches(rec) {
+		t.printf(recipeName, rec, "processor %q skipped record not selected by match", processor)
+	}
+}
+
+func (t *tracer) unrouted(recipeName, sink string, rec models.Record) {
+	if t.matches(rec) {
+		t.printf(recipeName, rec, "record not selected by when of sink %q", sink)
+	}
+}
+
+func (t *tracer) skipped(recipeName string, batch []models.Record) {
+	if t == nil {
+		return
+	}
+
+	for _, rec := range batch {
+		if t.matches(rec) {
+			t.printf(recipeName, rec, "dry-run: record not sent to sinks")
+		}
+	}
+}
+
+func (t *tracer) printf(recipeName string, rec models.Record, format string, args ...any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fmt.Fprintf(t.out, "[trace] recipe=%s urn=%s: %s\n", recipeName, rec.Entity().GetUrn(), fmt.Sprintf(format, args...))
+}
+
+// cloneRecord returns a deep copy of the record so that processors
+// mutating the entity in place do not affect the traced "before" state.
+func cloneRecord(rec models.Record) models.Record {
+	var entity *meteorv1beta1.Entity
+	if rec.Entity() != nil {
+		entity = proto.Clone(rec.Entity()).(*meteorv1beta1.Entity)
+	}
+
+	edges := make([]*meteorv1beta1.Edge, 0, len(rec.Edges()))
+	for _, e := range rec.Edges() {
+		edges = append(edges, proto.Clone(e).(*meteorv1beta1.Edge))
+	}
+	return models.NewRecord(entity, edges...)
+}